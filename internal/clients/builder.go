@@ -11,9 +11,10 @@ import (
 )
 
 type ClientBuilder struct {
-	AuthConfig       *auth.Config
-	PartnerID        string
-	TerraformVersion string
+	AuthConfig               *auth.Config
+	PartnerID                string
+	SkipCredentialValidation bool
+	TerraformVersion         string
 }
 
 // Build is a helper method which returns a fully instantiated *Client based on the auth Config's current settings.
@@ -60,7 +61,7 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 		}
 	}
 
-	if err := client.build(ctx, o); err != nil {
+	if err := client.build(ctx, o, b.SkipCredentialValidation); err != nil {
 		return nil, fmt.Errorf("building client: %+v", err)
 	}
 
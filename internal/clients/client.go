@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/manicminer/hamilton/auth"
 	"github.com/manicminer/hamilton/environments"
@@ -14,10 +15,13 @@ import (
 	applications "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	approleassignments "github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/client"
 	conditionalaccess "github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/client"
+	directoryobjects "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryobjects/client"
 	directoryroles "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
 	domains "github.com/hashicorp/terraform-provider-azuread/internal/services/domains/client"
 	groups "github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
+	identitygovernance "github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
 	invitations "github.com/hashicorp/terraform-provider-azuread/internal/services/invitations/client"
+	policies "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
 	serviceprincipals "github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/client"
 	users "github.com/hashicorp/terraform-provider-azuread/internal/services/users/client"
 )
@@ -31,21 +35,32 @@ type Client struct {
 
 	TerraformVersion string
 
+	// PreventEnabledAppRoleAndScopeRemoval causes an error to be raised at plan time when an enabled app role or
+	// permission scope is removed from an application, instead of a warning
+	PreventEnabledAppRoleAndScopeRemoval bool
+
 	StopContext context.Context
 
+	authorizer auth.Authorizer
+	claimsErr  error
+	claimsOnce sync.Once
+
 	AdministrativeUnits *administrativeunits.Client
 	Applications        *applications.Client
 	AppRoleAssignments  *approleassignments.Client
 	ConditionalAccess   *conditionalaccess.Client
+	DirectoryObjects    *directoryobjects.Client
 	DirectoryRoles      *directoryroles.Client
 	Domains             *domains.Client
 	Groups              *groups.Client
+	IdentityGovernance  *identitygovernance.Client
 	Invitations         *invitations.Client
+	Policies            *policies.Client
 	ServicePrincipals   *serviceprincipals.Client
 	Users               *users.Client
 }
 
-func (client *Client) build(ctx context.Context, o *common.ClientOptions) error {
+func (client *Client) build(ctx context.Context, o *common.ClientOptions, skipCredentialValidation bool) error {
 	client.StopContext = ctx
 
 	client.AdministrativeUnits = administrativeunits.NewClient(o)
@@ -53,14 +68,41 @@ func (client *Client) build(ctx context.Context, o *common.ClientOptions) error
 	client.AppRoleAssignments = approleassignments.NewClient(o)
 	client.Domains = domains.NewClient(o)
 	client.ConditionalAccess = conditionalaccess.NewClient(o)
+	client.DirectoryObjects = directoryobjects.NewClient(o)
 	client.DirectoryRoles = directoryroles.NewClient(o)
 	client.Groups = groups.NewClient(o)
+	client.IdentityGovernance = identitygovernance.NewClient(o)
 	client.Invitations = invitations.NewClient(o)
+	client.Policies = policies.NewClient(o)
 	client.ServicePrincipals = serviceprincipals.NewClient(o)
 	client.Users = users.NewClient(o)
 
-	// Acquire an access token upfront, so we can decode the JWT and populate the claims
-	token, err := o.Authorizer.Token()
+	client.authorizer = o.Authorizer
+
+	// Unless credential validation is skipped, acquire an access token upfront so that authentication errors are
+	// surfaced immediately
+	if !skipCredentialValidation {
+		return client.LoadClaims()
+	}
+
+	return nil
+}
+
+// LoadClaims acquires an access token and decodes the JWT to populate Claims. This happens when the client is built,
+// unless credential validation was skipped, in which case it is deferred until the claims are first needed.
+func (client *Client) LoadClaims() error {
+	client.claimsOnce.Do(func() {
+		client.claimsErr = client.loadClaims()
+	})
+	return client.claimsErr
+}
+
+func (client *Client) loadClaims() error {
+	if client.authorizer == nil {
+		return fmt.Errorf("unable to obtain access token: authorizer is nil")
+	}
+
+	token, err := client.authorizer.Token()
 	if err != nil {
 		return fmt.Errorf("unable to obtain access token: %v", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/go-uuid"
@@ -20,6 +21,15 @@ import (
 
 type contextKey string
 
+// sensitiveFieldsRegex matches the values of JSON properties in request and response bodies which contain secrets, so
+// that they can be redacted before being written to debug logs
+var sensitiveFieldsRegex = regexp.MustCompile(`("(?i:password|secretText)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// redactSensitiveValues replaces any secret values in the provided HTTP request or response dump
+func redactSensitiveValues(dump []byte) []byte {
+	return sensitiveFieldsRegex.ReplaceAll(dump, []byte(`${1}"[REDACTED]"`))
+}
+
 type ClientOptions struct {
 	Environment environments.Environment
 	TenantID    string
@@ -74,7 +84,7 @@ Request ID: %s
 
 %s
 ============================= End AzureAD Request =============================
-`, requestId, dump)
+`, requestId, redactSensitiveValues(dump))
 	} else {
 		// fallback to basic message
 		log.Printf("[DEBUG] AzureAD Request %s: %s %s\n", requestId, newReq.Method, newReq.URL)
@@ -103,7 +113,7 @@ Request ID: %s
 
 %s
 ============================= End AzureAD Response ============================
-`, req.Method, req.URL, requestId, dump)
+`, req.Method, req.URL, requestId, redactSensitiveValues(dump))
 		} else {
 			log.Printf("[DEBUG] AzureAD Response: %s for %s (%s %s)\n", resp.Status, requestId, req.Method, req.URL)
 		}
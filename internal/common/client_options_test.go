@@ -0,0 +1,39 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestRedactSensitiveValues(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    `{"displayName":"acctest","keyId":"00000000-0000-0000-0000-000000000000"}`,
+			expected: `{"displayName":"acctest","keyId":"00000000-0000-0000-0000-000000000000"}`,
+		},
+		{
+			input:    `{"displayName":"acctest","secretText":"s3cr3t~Value.123"}`,
+			expected: `{"displayName":"acctest","secretText":"[REDACTED]"}`,
+		},
+		{
+			input:    `{"passwordProfile": {"forceChangePasswordNextSignIn": true, "password": "p@ss\"word"}}`,
+			expected: `{"passwordProfile": {"forceChangePasswordNextSignIn": true, "password": "[REDACTED]"}}`,
+		},
+		{
+			input:    "{\n  \"Password\" : \"hunter2\",\n  \"secretText\": null\n}",
+			expected: "{\n  \"Password\" : \"[REDACTED]\",\n  \"secretText\": null\n}",
+		},
+		{
+			input:    `{"passwordCredentials":[{"hint":"abc","secretText":"one"},{"hint":"def","secretText":"two"}]}`,
+			expected: `{"passwordCredentials":[{"hint":"abc","secretText":"[REDACTED]"},{"hint":"def","secretText":"[REDACTED]"}]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := string(redactSensitiveValues([]byte(tc.input))); actual != tc.expected {
+			t.Fatalf("Expected %q, got %q", tc.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package helpers
+
+import (
+	"github.com/manicminer/hamilton/odata"
+)
+
+// AdvancedQuery returns a copy of the provided query which makes use of the advanced query capabilities of the Microsoft
+// Graph API, by sending the `ConsistencyLevel: eventual` header along with the `$count` parameter. Advanced queries are
+// needed for `$search`, `$count` and some filter expressions against directory objects, at the cost of results which
+// may not yet reflect the most recent changes.
+func AdvancedQuery(query odata.Query) odata.Query {
+	query.ConsistencyLevel = odata.ConsistencyLevelEventual
+	query.Count = true
+	return query
+}
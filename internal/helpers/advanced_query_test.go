@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/manicminer/hamilton/odata"
+)
+
+func TestAdvancedQuery(t *testing.T) {
+	query := odata.Query{
+		Filter: "startsWith(displayName, 'acctest')",
+		Select: []string{"id"},
+	}
+
+	actual := AdvancedQuery(query)
+	if actual.ConsistencyLevel != odata.ConsistencyLevelEventual {
+		t.Fatalf("Expected ConsistencyLevel %q, got %q", odata.ConsistencyLevelEventual, actual.ConsistencyLevel)
+	}
+	if !actual.Count {
+		t.Fatalf("Expected Count to be true")
+	}
+	if actual.Filter != query.Filter || len(actual.Select) != 1 {
+		t.Fatalf("Expected other query options to be preserved, got %#v", actual)
+	}
+	if query.ConsistencyLevel != "" || query.Count {
+		t.Fatalf("Expected the original query to be left unchanged, got %#v", query)
+	}
+
+	headers := actual.Headers()
+	if v := headers.Get("ConsistencyLevel"); v != "eventual" {
+		t.Fatalf("Expected ConsistencyLevel header to be %q, got %q", "eventual", v)
+	}
+	if v := actual.Values().Get("$count"); v != "true" {
+		t.Fatalf("Expected $count parameter to be %q, got %q", "true", v)
+	}
+}
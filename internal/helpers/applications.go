@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/manicminer/hamilton/msgraph"
@@ -160,6 +162,129 @@ func ApplicationFlattenFeatures(tags *[]string, deprecated bool) []interface{} {
 	return []interface{}{result}
 }
 
+// ApplicationMergeFeatureTags replaces any feature tags found in existingTags with featureTags, preserving any other
+// tags so that those added outside of Terraform are not removed when managing feature tags
+func ApplicationMergeFeatureTags(existingTags, featureTags []string) []string {
+	result := make([]string, 0)
+
+	for _, tag := range existingTags {
+		isFeatureTag := false
+		for _, featureTag := range ApplicationExpandFeatures([]interface{}{map[string]interface{}{
+			"custom_single_sign_on": true,
+			"enterprise":            true,
+			"gallery":               true,
+			"hide":                  true,
+		}}) {
+			if strings.EqualFold(tag, featureTag) {
+				isFeatureTag = true
+				break
+			}
+		}
+		if !isFeatureTag {
+			result = append(result, tag)
+		}
+	}
+
+	return append(result, featureTags...)
+}
+
+// ApplicationFlattenGroupMembershipClaims normalizes the group membership claims returned by the API, which are stored
+// as a single comma-separated string and may be returned in any order or letter case. Claims are deduplicated and
+// sorted, and `None` is omitted when any other claim is present since it has no effect.
+func ApplicationFlattenGroupMembershipClaims(in *[]msgraph.GroupMembershipClaim) []string {
+	result := make([]string, 0)
+	if in == nil {
+		return result
+	}
+
+	known := []string{
+		msgraph.GroupMembershipClaimAll,
+		msgraph.GroupMembershipClaimNone,
+		msgraph.GroupMembershipClaimApplicationGroup,
+		msgraph.GroupMembershipClaimDirectoryRole,
+		msgraph.GroupMembershipClaimSecurityGroup,
+	}
+
+	seen := make(map[string]bool)
+	for _, claim := range *in {
+		claim = strings.TrimSpace(claim)
+		if claim == "" {
+			continue
+		}
+		for _, k := range known {
+			if strings.EqualFold(claim, k) {
+				claim = k
+				break
+			}
+		}
+		if !seen[claim] {
+			seen[claim] = true
+			result = append(result, claim)
+		}
+	}
+
+	if len(result) > 1 && seen[msgraph.GroupMembershipClaimNone] {
+		claims := make([]string, 0, len(result)-1)
+		for _, claim := range result {
+			if claim != msgraph.GroupMembershipClaimNone {
+				claims = append(claims, claim)
+			}
+		}
+		result = claims
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// ApplicationFlattenOptionalClaim flattens a list of optional claims for a single token type. Directory extension claims
+// (e.g. `extension_<appId>_<name>`) are returned with a `source` of `user`, which is normalized to lower case so that
+// it matches the configured value, and a missing `essential` value is treated as `false`.
+func ApplicationFlattenOptionalClaim(in *[]msgraph.OptionalClaim) []interface{} {
+	optionalClaims := make([]interface{}, 0)
+	if in == nil {
+		return optionalClaims
+	}
+
+	for _, claim := range *in {
+		name := ""
+		if claim.Name != nil {
+			name = *claim.Name
+		}
+
+		essential := false
+		if claim.Essential != nil {
+			essential = *claim.Essential
+		}
+
+		source := ""
+		if claim.Source != nil {
+			source = *claim.Source
+			if strings.EqualFold(source, "user") {
+				source = "user"
+			}
+		}
+
+		// Additional properties are always configured in lower case, but the API does not always return them as such
+		additionalProperties := make([]string, 0)
+		if claim.AdditionalProperties != nil {
+			for _, prop := range *claim.AdditionalProperties {
+				additionalProperties = append(additionalProperties, strings.ToLower(prop))
+			}
+		}
+
+		optionalClaims = append(optionalClaims, map[string]interface{}{
+			"name":                  name,
+			"essential":             essential,
+			"source":                source,
+			"additional_properties": additionalProperties,
+		})
+	}
+
+	return optionalClaims
+}
+
 func ApplicationFlattenOAuth2PermissionScopeIDs(in *[]msgraph.PermissionScope) map[string]string {
 	result := make(map[string]string)
 	if in != nil {
@@ -224,3 +349,36 @@ func ApplicationFlattenOAuth2PermissionScopes(in *[]msgraph.PermissionScope) (re
 
 	return //nolint:nakedret
 }
+
+// ApplicationFlattenIdentifierUris returns the identifier URIs for an application, omitting the default `api://{appId}`
+// URI that the API may add automatically, unless it is present in the provided list of known URIs (i.e. those which
+// are configured or already in state).
+func ApplicationFlattenIdentifierUris(in *[]string, appId *string, known []interface{}) []string {
+	result := make([]string, 0)
+	if in == nil {
+		return result
+	}
+
+	defaultUri := ""
+	if appId != nil && *appId != "" {
+		defaultUri = fmt.Sprintf("api://%s", *appId)
+	}
+
+	keepDefault := false
+	for _, v := range known {
+		if s, ok := v.(string); ok && defaultUri != "" && strings.EqualFold(s, defaultUri) {
+			keepDefault = true
+			break
+		}
+	}
+
+	for _, uri := range *in {
+		if defaultUri != "" && !keepDefault && strings.EqualFold(uri, defaultUri) {
+			continue
+		}
+		result = append(result, uri)
+	}
+
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,209 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestApplicationFlattenGroupMembershipClaims(t *testing.T) {
+	cases := []struct {
+		Input    *[]msgraph.GroupMembershipClaim
+		Expected []string
+	}{
+		{
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Input:    &[]msgraph.GroupMembershipClaim{},
+			Expected: []string{},
+		},
+		{
+			Input:    &[]msgraph.GroupMembershipClaim{"All"},
+			Expected: []string{"All"},
+		},
+		{
+			Input:    &[]msgraph.GroupMembershipClaim{"None"},
+			Expected: []string{"None"},
+		},
+		{
+			Input:    &[]msgraph.GroupMembershipClaim{"SecurityGroup", "ApplicationGroup"},
+			Expected: []string{"ApplicationGroup", "SecurityGroup"},
+		},
+		{
+			Input:    &[]msgraph.GroupMembershipClaim{" securitygroup", "SecurityGroup ", "DIRECTORYROLE"},
+			Expected: []string{"DirectoryRole", "SecurityGroup"},
+		},
+		{
+			Input:    &[]msgraph.GroupMembershipClaim{"None", "ApplicationGroup", ""},
+			Expected: []string{"ApplicationGroup"},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := ApplicationFlattenGroupMembershipClaims(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %#v for input %#v, got %#v", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestApplicationFlattenOptionalClaim(t *testing.T) {
+	extensionName := "extension_00000000000000000000000000000001_department"
+
+	cases := []struct {
+		Input    *[]msgraph.OptionalClaim
+		Expected []interface{}
+	}{
+		{
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Input: &[]msgraph.OptionalClaim{{Name: utils.String("upn")}},
+			Expected: []interface{}{map[string]interface{}{
+				"name":                  "upn",
+				"essential":             false,
+				"source":                "",
+				"additional_properties": []string{},
+			}},
+		},
+		{
+			Input: &[]msgraph.OptionalClaim{{
+				Name:      utils.String(extensionName),
+				Source:    utils.String("user"),
+				Essential: utils.Bool(true),
+			}},
+			Expected: []interface{}{map[string]interface{}{
+				"name":                  extensionName,
+				"essential":             true,
+				"source":                "user",
+				"additional_properties": []string{},
+			}},
+		},
+		{
+			Input: &[]msgraph.OptionalClaim{{
+				Name:                 utils.String(extensionName),
+				Source:               utils.String("User"),
+				Essential:            utils.Bool(false),
+				AdditionalProperties: &[]string{"emit_as_roles"},
+			}},
+			Expected: []interface{}{map[string]interface{}{
+				"name":                  extensionName,
+				"essential":             false,
+				"source":                "user",
+				"additional_properties": []string{"emit_as_roles"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := ApplicationFlattenOptionalClaim(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %#v for input %#v, got %#v", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestApplicationFlattenIdentifierUris(t *testing.T) {
+	appId := "00000000-0000-0000-0000-000000000001"
+
+	cases := []struct {
+		Input    *[]string
+		AppId    *string
+		Known    []interface{}
+		Expected []string
+	}{
+		{
+			Input:    nil,
+			AppId:    &appId,
+			Expected: []string{},
+		},
+		{
+			Input:    &[]string{"https://example.com/app", "api://example"},
+			AppId:    &appId,
+			Known:    []interface{}{"api://example", "https://example.com/app"},
+			Expected: []string{"api://example", "https://example.com/app"},
+		},
+		{
+			Input:    &[]string{"api://example", "https://example.com/app"},
+			AppId:    &appId,
+			Known:    []interface{}{"https://example.com/app", "api://example"},
+			Expected: []string{"api://example", "https://example.com/app"},
+		},
+		{
+			Input:    &[]string{"api://" + appId},
+			AppId:    &appId,
+			Expected: []string{},
+		},
+		{
+			Input:    &[]string{"api://example", "api://" + appId},
+			AppId:    &appId,
+			Known:    []interface{}{"api://example"},
+			Expected: []string{"api://example"},
+		},
+		{
+			Input:    &[]string{"api://example", "api://" + appId},
+			AppId:    &appId,
+			Known:    []interface{}{"api://example", "api://" + appId},
+			Expected: []string{"api://" + appId, "api://example"},
+		},
+		{
+			Input:    &[]string{"api://example"},
+			AppId:    nil,
+			Expected: []string{"api://example"},
+		},
+		{
+			Input:    &[]string{"api://example"},
+			AppId:    utils.String(""),
+			Expected: []string{"api://example"},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := ApplicationFlattenIdentifierUris(tc.Input, tc.AppId, tc.Known)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %#v for input %#v (known: %#v), got %#v", tc.Expected, tc.Input, tc.Known, actual)
+		}
+	}
+}
+
+func TestApplicationMergeFeatureTags(t *testing.T) {
+	cases := []struct {
+		Existing    []string
+		FeatureTags []string
+		Expected    []string
+	}{
+		{
+			Existing:    nil,
+			FeatureTags: []string{"HideApp"},
+			Expected:    []string{"HideApp"},
+		},
+		{
+			Existing:    []string{"HideApp", "WindowsAzureActiveDirectoryIntegratedApp"},
+			FeatureTags: []string{},
+			Expected:    []string{},
+		},
+		{
+			Existing:    []string{"hideapp", "PortalTag", "WindowsAzureActiveDirectoryIntegratedApp"},
+			FeatureTags: []string{"WindowsAzureActiveDirectoryIntegratedApp"},
+			Expected:    []string{"PortalTag", "WindowsAzureActiveDirectoryIntegratedApp"},
+		},
+		{
+			Existing:    []string{"PortalTag", "AnotherTag"},
+			FeatureTags: []string{"WindowsAzureActiveDirectoryCustomSingleSignOnApplication", "HideApp"},
+			Expected:    []string{"PortalTag", "AnotherTag", "WindowsAzureActiveDirectoryCustomSingleSignOnApplication", "HideApp"},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := ApplicationMergeFeatureTags(tc.Existing, tc.FeatureTags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %#v for existing tags %#v and feature tags %#v, got %#v", tc.Expected, tc.Existing, tc.FeatureTags, actual)
+		}
+	}
+}
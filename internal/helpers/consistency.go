@@ -41,3 +41,41 @@ func WaitForDeletion(ctx context.Context, f existsFunc) error {
 
 	return err
 }
+
+type changeFunc func(ctx context.Context) (*bool, error)
+
+// WaitForUpdate polls the provided function until it reports that the expected change has been observed for the
+// specified number of consecutive reads, or the context deadline is reached. This is intended for properties that are
+// eventually consistent, where subsequent reads may not immediately reflect a completed write. Reads that can be
+// served by different replicas should require several consecutive observations, whereas reads that are consistent
+// once the change is first observed should require only one, to avoid needlessly delaying the operation.
+func WaitForUpdate(ctx context.Context, occurrences int, f changeFunc) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return errors.New("context has no deadline")
+	}
+
+	timeout := time.Until(deadline)
+	_, err := (&resource.StateChangeConf{
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   timeout,
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: occurrences,
+		Refresh: func() (interface{}, string, error) {
+			updated, err := f(ctx)
+			if err != nil {
+				return nil, "Error", fmt.Errorf("retrieving resource: %+v", err)
+			}
+			if updated == nil {
+				return nil, "Error", fmt.Errorf("retrieving resource: updated was nil")
+			}
+			if *updated {
+				return "stub", "Done", nil
+			}
+			return "stub", "Waiting", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	return err
+}
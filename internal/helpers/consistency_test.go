@@ -0,0 +1,29 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestWaitForUpdate_occurrences(t *testing.T) {
+	for _, occurrences := range []int{1, 3} {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+
+		calls := 0
+		err := WaitForUpdate(ctx, occurrences, func(ctx context.Context) (*bool, error) {
+			calls++
+			return utils.Bool(true), nil
+		})
+		cancel()
+
+		if err != nil {
+			t.Fatalf("Expected no error with %d occurrences, got: %+v", occurrences, err)
+		}
+		if calls != occurrences {
+			t.Fatalf("Expected %d calls with %d occurrences, got %d", occurrences, occurrences, calls)
+		}
+	}
+}
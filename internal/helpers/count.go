@@ -0,0 +1,43 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+// CountDirectoryObjects returns the number of objects in a directory collection, such as `/users` or `/groups`,
+// matching the optional filter. This uses an advanced query with `$count`, so that only a single object needs to be
+// retrieved regardless of the size of the collection.
+func CountDirectoryObjects(ctx context.Context, client msgraph.Client, entity, filter string) (int, error) {
+	resp, status, o, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		DisablePaging: true,
+		OData: AdvancedQuery(odata.Query{
+			Filter: filter,
+			Select: []string{"id"},
+			Top:    1,
+		}),
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		if status == http.StatusBadRequest || status == http.StatusForbidden {
+			return 0, fmt.Errorf("counting objects using an advanced query failed (status %d), advanced queries may not be permitted in this tenant: %v", status, err)
+		}
+		return 0, fmt.Errorf("counting objects: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if o == nil || o.Count == nil {
+		return 0, errors.New("API response did not include an object count")
+	}
+
+	return *o.Count, nil
+}
@@ -105,37 +105,39 @@ func KeyCredentialForResource(d *schema.ResourceData) (*msgraph.KeyCredential, e
 		keyId = kid
 	}
 
-	var endDate time.Time
+	var startDate *time.Time
+	if v, ok := d.GetOk("start_date"); ok {
+		start, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
+		}
+		startDate = &start
+	}
+
+	var endDate *time.Time
 	if v := d.Get("end_date").(string); v != "" {
-		var err error
-		endDate, err = time.Parse(time.RFC3339, v)
+		end, err := time.Parse(time.RFC3339, v)
 		if err != nil {
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided end date %q: %+v", v, err), attr: "end_date"}
 		}
+		endDate = &end
 	} else if v := d.Get("end_date_relative").(string); v != "" {
-		d, err := time.ParseDuration(v)
+		var err error
+		endDate, err = relativeEndDate(startDate, v)
 		if err != nil {
-			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
+			return nil, err
 		}
-		endDate = time.Now().Add(d)
 	} else {
 		return nil, CredentialError{str: "One of `end_date` or `end_date_relative` must be specified", attr: "end_date"}
 	}
 
 	credential := msgraph.KeyCredential{
-		KeyId:       utils.String(keyId),
-		Type:        keyType,
-		Usage:       msgraph.KeyCredentialUsageVerify,
-		Key:         utils.String(encodedValue),
-		EndDateTime: &endDate,
-	}
-
-	if v, ok := d.GetOk("start_date"); ok {
-		startDate, err := time.Parse(time.RFC3339, v.(string))
-		if err != nil {
-			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
-		}
-		credential.StartDateTime = &startDate
+		KeyId:         utils.String(keyId),
+		Type:          keyType,
+		Usage:         msgraph.KeyCredentialUsageVerify,
+		Key:           utils.String(encodedValue),
+		StartDateTime: startDate,
+		EndDateTime:   endDate,
 	}
 
 	return &credential, nil
@@ -166,12 +168,11 @@ func PasswordCredentialForResource(d *schema.ResourceData) (*msgraph.PasswordCre
 		}
 		endDate = &expiry
 	} else if v, ok := d.GetOk("end_date_relative"); ok && v.(string) != "" {
-		d, err := time.ParseDuration(v.(string))
+		var err error
+		endDate, err = relativeEndDate(credential.StartDateTime, v.(string))
 		if err != nil {
-			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
+			return nil, err
 		}
-		expiry := time.Now().Add(d)
-		endDate = &expiry
 	}
 	if endDate != nil {
 		credential.EndDateTime = endDate
@@ -179,3 +180,20 @@ func PasswordCredentialForResource(d *schema.ResourceData) (*msgraph.PasswordCre
 
 	return &credential, nil
 }
+
+// relativeEndDate calculates an end date from the provided relative duration. The end date is calculated from the start
+// date when one is specified, otherwise from the current time, and is truncated to whole seconds in UTC so that the
+// resulting value matches the precision with which it is stored by the API.
+func relativeEndDate(startDate *time.Time, relativeDuration string) (*time.Time, error) {
+	base := time.Now()
+	if startDate != nil {
+		base = *startDate
+	}
+
+	endDate, err := utils.AddRelativeDuration(base.UTC().Truncate(time.Second), relativeDuration)
+	if err != nil {
+		return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a relative duration, expected %s", relativeDuration, utils.RelativeDurationFormat), attr: "end_date_relative"}
+	}
+
+	return endDate, nil
+}
@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var mailNicknameInvalidChars = regexp.MustCompile(`[@()\\\[\]";:<>,\s]`)
+
+// DeriveMailNickname derives a mail nickname from the provided value in the same manner as the Azure Portal, by using
+// the portion of the value preceding any `@` (e.g. for a user principal name) and removing any characters that are
+// not permitted in a mail nickname.
+func DeriveMailNickname(in string) string {
+	nickname := strings.Split(in, "@")[0]
+	nickname = mailNicknameInvalidChars.ReplaceAllString(nickname, "")
+	return strings.Trim(nickname, ".")
+}
+
+// IsMailNicknameConflict returns true if the provided error indicates that the API rejected a request because the
+// specified mail nickname is already in use by another directory object.
+func IsMailNicknameConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "same value for property mailnickname")
+}
+
+// MailNicknameConflictDiag returns a diagnostic for a mail nickname conflict, suggesting an alternative value
+func MailNicknameConflictDiag(err error, resourceName, mailNickname string) diag.Diagnostics {
+	charSet := "0123456789abcdef"
+	suffix := make([]byte, 4)
+	r := rand.New(rand.NewSource(time.Now().UTC().UnixNano())) //nolint:gosec
+	for i := range suffix {
+		suffix[i] = charSet[r.Intn(len(charSet))]
+	}
+
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("The mail nickname %q is already in use by another object in the directory", mailNickname),
+		Detail: fmt.Sprintf("Mail nicknames must be unique within the tenant. Please specify a different value for the `mail_nickname` property of this %q resource, for example %q.\n\nAPI error: %v",
+			resourceName, fmt.Sprintf("%s-%s", mailNickname, suffix), err),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "mail_nickname"}},
+	}}
+}
+
+// IsMailConflict returns true if the provided error indicates that the API rejected a request because the specified
+// mail address is already in use as a proxy address by another directory object.
+func IsMailConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "same value for property proxyaddresses")
+}
+
+// MailConflictDiag returns a diagnostic for a mail address conflict
+func MailConflictDiag(err error, resourceName, mail string) diag.Diagnostics {
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("The mail address %q is already in use by another object in the directory", mail),
+		Detail: fmt.Sprintf("Mail addresses must be unique within the tenant, including any proxy addresses derived from a mail nickname. Please specify a different value for the `mail` property of this %q resource.\n\nAPI error: %v",
+			resourceName, err),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "mail"}},
+	}}
+}
+
+// IsMailExchangeManaged returns true if the provided error indicates that the API rejected a request to set the mail
+// address of an object, because the property is managed by Exchange Online for an object with a mailbox.
+func IsMailExchangeManaged(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "originated within an external service")
+}
+
+// MailExchangeManagedDiag returns a diagnostic for a mail address that could not be set because it is managed by
+// Exchange Online
+func MailExchangeManagedDiag(err error, resourceName, mail string) diag.Diagnostics {
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("The mail address %q could not be set because it is managed by Exchange Online", mail),
+		Detail: fmt.Sprintf("The mail address of an object with an Exchange Online mailbox can only be changed using Exchange Online. Please remove the `mail` property of this %q resource from your configuration, or update the primary SMTP address of the mailbox instead.\n\nAPI error: %v",
+			resourceName, err),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "mail"}},
+	}}
+}
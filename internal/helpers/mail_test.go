@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeriveMailNickname(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "alice",
+			Expected: "alice",
+		},
+		{
+			Input:    "alice.smith@contoso.onmicrosoft.com",
+			Expected: "alice.smith",
+		},
+		{
+			Input:    "Marketing Team (EMEA)",
+			Expected: "MarketingTeamEMEA",
+		},
+		{
+			Input:    ".leading.and.trailing.",
+			Expected: "leading.and.trailing",
+		},
+		{
+			Input:    `a"b;c:d<e>f,g[h]i\j`,
+			Expected: "abcdefghij",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			if actual := DeriveMailNickname(tc.Input); actual != tc.Expected {
+				t.Fatalf("Expected %q, got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsMailNicknameConflict(t *testing.T) {
+	cases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{
+			Err:      nil,
+			Expected: false,
+		},
+		{
+			Err:      errors.New("GroupsClient.BaseClient.Post(): unexpected status 400 with OData error: Request_BadRequest: Another object with the same value for property mailNickname already exists."),
+			Expected: true,
+		},
+		{
+			Err:      errors.New("GroupsClient.BaseClient.Post(): unexpected status 403 with OData error: Authorization_RequestDenied: Insufficient privileges to complete the operation."),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := IsMailNicknameConflict(tc.Err); actual != tc.Expected {
+			t.Fatalf("Expected %t for error %v, got %t", tc.Expected, tc.Err, actual)
+		}
+	}
+}
+
+func TestIsMailConflict(t *testing.T) {
+	cases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{
+			Err:      nil,
+			Expected: false,
+		},
+		{
+			Err:      errors.New("UsersClient.BaseClient.Patch(): unexpected status 400 with OData error: Request_BadRequest: Another object with the same value for property proxyAddresses already exists."),
+			Expected: true,
+		},
+		{
+			Err:      errors.New("UsersClient.BaseClient.Post(): unexpected status 400 with OData error: Request_BadRequest: Another object with the same value for property mailNickname already exists."),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := IsMailConflict(tc.Err); actual != tc.Expected {
+			t.Fatalf("Expected %t for error %v, got %t", tc.Expected, tc.Err, actual)
+		}
+	}
+}
+
+func TestIsMailExchangeManaged(t *testing.T) {
+	cases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{
+			Err:      nil,
+			Expected: false,
+		},
+		{
+			Err:      errors.New("UsersClient.BaseClient.Patch(): unexpected status 400 with OData error: Request_BadRequest: Unable to update the specified properties for objects that have originated within an external service."),
+			Expected: true,
+		},
+		{
+			Err:      errors.New("UsersClient.BaseClient.Patch(): unexpected status 400 with OData error: Request_BadRequest: Another object with the same value for property proxyAddresses already exists."),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := IsMailExchangeManaged(tc.Err); actual != tc.Expected {
+			t.Fatalf("Expected %t for error %v, got %t", tc.Expected, tc.Err, actual)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// IsThrottled returns true if the provided HTTP status code indicates that a request was throttled, or that the
+// service was temporarily unable to handle it, such that the request can be safely reattempted later.
+func IsThrottled(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+}
+
+type throttledFunc func(ctx context.Context) (status int, err error)
+
+// RetryOnThrottling invokes the provided function, reattempting it for as long as it returns a throttling status (429
+// or 503), until the context deadline is reached. Each individual attempt is already retried by the underlying
+// retryable HTTP client, which honours any `Retry-After` header returned by the API, however its retry limit can be
+// exhausted during sustained throttling (e.g. when creating many resources in parallel), so this helper extends the
+// retry window to the full resource timeout. Any other error is returned immediately.
+func RetryOnThrottling(ctx context.Context, f throttledFunc) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return errors.New("context has no deadline")
+	}
+
+	return resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		status, err := f(ctx)
+		if err != nil {
+			if IsThrottled(status) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestIsThrottled(t *testing.T) {
+	cases := []struct {
+		Status   int
+		Expected bool
+	}{
+		{Status: http.StatusOK, Expected: false},
+		{Status: http.StatusBadRequest, Expected: false},
+		{Status: http.StatusNotFound, Expected: false},
+		{Status: http.StatusTooManyRequests, Expected: true},
+		{Status: http.StatusInternalServerError, Expected: false},
+		{Status: http.StatusServiceUnavailable, Expected: true},
+	}
+
+	for _, tc := range cases {
+		if actual := IsThrottled(tc.Status); actual != tc.Expected {
+			t.Fatalf("Expected IsThrottled(%d) to return %t, got %t", tc.Status, tc.Expected, actual)
+		}
+	}
+}
+
+func TestRetryOnThrottling_createUser(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":{"code":"TooManyRequests","message":"Too many requests"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"00000000-0000-0000-0000-000000000001","userPrincipalName":"alice@example.com"}`))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewUsersClient("00000000-0000-0000-0000-000000000000")
+	client.BaseClient.Endpoint = environments.ApiEndpoint(server.URL)
+
+	// Disable retries in the underlying HTTP client so that the throttled response reaches the helper
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var user *msgraph.User
+	err := RetryOnThrottling(ctx, func(ctx context.Context) (status int, err error) {
+		user, status, err = client.Create(ctx, msgraph.User{UserPrincipalName: utils.String("alice@example.com")})
+		return
+	})
+	if err != nil {
+		t.Fatalf("Expected user to be created, got error: %v", err)
+	}
+	if user == nil || user.ID == nil || *user.ID != "00000000-0000-0000-0000-000000000001" {
+		t.Fatalf("Expected created user to be returned, got: %+v", user)
+	}
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestRetryOnThrottling_nonRetryableError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	attempts := 0
+	err := RetryOnThrottling(ctx, func(ctx context.Context) (int, error) {
+		attempts++
+		return http.StatusBadRequest, errors.New("bad request")
+	})
+	if err == nil {
+		t.Fatalf("Expected an error to be returned")
+	}
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt, got %d", attempts)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -79,6 +80,13 @@ func AzureADProvider() *schema.Provider {
 				Description: "The Client ID which should be used for service principal authentication",
 			},
 
+			"client_id_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_ID_FILE_PATH", ""),
+				Description: "The path to a file containing the Client ID which should be used for service principal authentication",
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -86,6 +94,13 @@ func AzureADProvider() *schema.Provider {
 				Description: "The Tenant ID which should be used. Works with all authentication methods except Managed Identity",
 			},
 
+			"tenant_id_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID_FILE_PATH", ""),
+				Description: "The path to a file containing the Tenant ID which should be used",
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -123,6 +138,13 @@ func AzureADProvider() *schema.Provider {
 				Description: "The application password to use when authenticating as a Service Principal using a Client Secret",
 			},
 
+			"client_secret_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET_FILE_PATH", ""),
+				Description: "The path to a file containing the application password to use when authenticating as a Service Principal using a Client Secret",
+			},
+
 			// CLI authentication specific fields
 			"use_cli": {
 				Type:        schema.TypeBool,
@@ -161,6 +183,20 @@ func AzureADProvider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_TERRAFORM_PARTNER_ID", false),
 				Description: "Disable the Terraform Partner ID, which is used if a custom `partner_id` isn't specified",
 			},
+
+			"skip_credential_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_CREDENTIAL_VALIDATION", false),
+				Description: "Defer acquiring an access token until one is needed to make an API request, instead of validating credentials when the provider is configured",
+			},
+
+			"prevent_enabled_app_role_and_scope_removal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_PREVENT_ENABLED_APP_ROLE_AND_SCOPE_REMOVAL", false),
+				Description: "Raise an error, instead of a warning, when an enabled app role or OAuth2 permission scope is removed from an application",
+			},
 		},
 
 		ResourcesMap:   resources,
@@ -189,14 +225,29 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, diag.Errorf("Parsing environment %q: %v", envName, err)
 		}
 
+		tenantId, err := valueFromFilePath(d.Get("tenant_id").(string), d.Get("tenant_id_file_path").(string), "tenant_id", "ARM_TENANT_ID")
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		clientId, err := valueFromFilePath(d.Get("client_id").(string), d.Get("client_id_file_path").(string), "client_id", "ARM_CLIENT_ID")
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		clientSecret, err := valueFromFilePath(d.Get("client_secret").(string), d.Get("client_secret_file_path").(string), "client_secret", "ARM_CLIENT_SECRET")
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
 		authConfig := &auth.Config{
 			Environment:            env,
-			TenantID:               d.Get("tenant_id").(string),
-			ClientID:               d.Get("client_id").(string),
+			TenantID:               tenantId,
+			ClientID:               clientId,
 			ClientCertData:         certData,
 			ClientCertPassword:     d.Get("client_certificate_password").(string),
 			ClientCertPath:         d.Get("client_certificate_path").(string),
-			ClientSecret:           d.Get("client_secret").(string),
+			ClientSecret:           clientSecret,
 			EnableClientCertAuth:   true,
 			EnableClientSecretAuth: true,
 			EnableAzureCliToken:    d.Get("use_cli").(bool),
@@ -212,15 +263,21 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			partnerId = terraformPartnerId
 		}
 
-		return buildClient(ctx, p, authConfig, partnerId)
+		client, diags := buildClient(ctx, p, authConfig, partnerId, d.Get("skip_credential_validation").(bool))
+		if client != nil {
+			client.PreventEnabledAppRoleAndScopeRemoval = d.Get("prevent_enabled_app_role_and_scope_removal").(bool)
+		}
+
+		return client, diags
 	}
 }
 
-func buildClient(ctx context.Context, p *schema.Provider, authConfig *auth.Config, partnerId string) (*clients.Client, diag.Diagnostics) {
+func buildClient(ctx context.Context, p *schema.Provider, authConfig *auth.Config, partnerId string, skipCredentialValidation bool) (*clients.Client, diag.Diagnostics) {
 	clientBuilder := clients.ClientBuilder{
-		AuthConfig:       authConfig,
-		PartnerID:        partnerId,
-		TerraformVersion: p.TerraformVersion,
+		AuthConfig:               authConfig,
+		PartnerID:                partnerId,
+		SkipCredentialValidation: skipCredentialValidation,
+		TerraformVersion:         p.TerraformVersion,
 	}
 
 	stopCtx, ok := schema.StopContext(ctx) //nolint:staticcheck
@@ -248,3 +305,32 @@ func decodeCertificate(clientCertificate string) ([]byte, error) {
 	}
 	return pfx, nil
 }
+
+// valueFromFilePath returns the contents of the file at filePath with any surrounding whitespace removed, or value when
+// no file path is specified. When both are specified, the file contents take precedence but must match value, unless
+// value was sourced from the environment variable envVar, in which case the file contents are used regardless.
+func valueFromFilePath(value, filePath, fieldName, envVar string) (string, error) {
+	if filePath == "" {
+		return value, nil
+	}
+
+	fileValue, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("reading `%s_file_path` %q: %v", fieldName, filePath, err)
+	}
+
+	trimmed := strings.TrimSpace(string(fileValue))
+	if trimmed == "" {
+		return "", fmt.Errorf("`%s_file_path` %q refers to an empty file", fieldName, filePath)
+	}
+
+	if value != "" && value != trimmed {
+		if value == os.Getenv(envVar) {
+			log.Printf("[DEBUG] Using the contents of `%s_file_path` instead of the value of the %s environment variable", fieldName, envVar)
+			return trimmed, nil
+		}
+		return "", fmt.Errorf("mismatch between the supplied `%s` and the contents of `%s_file_path`", fieldName, fieldName)
+	}
+
+	return trimmed, nil
+}
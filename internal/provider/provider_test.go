@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,6 +26,79 @@ func TestProvider_impl(t *testing.T) {
 	var _ = AzureADProvider()
 }
 
+func TestProvider_valueFromFilePath(t *testing.T) {
+	dir := t.TempDir()
+
+	validPath := filepath.Join(dir, "client_secret")
+	if err := os.WriteFile(validPath, []byte("  s3cr3t\n"), 0600); err != nil {
+		t.Fatalf("writing test file: %v", err)
+	}
+
+	emptyPath := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyPath, []byte("\n"), 0600); err != nil {
+		t.Fatalf("writing test file: %v", err)
+	}
+
+	// A value sourced from the environment should be overridden by the file contents
+	os.Setenv("ARM_CLIENT_SECRET", "fromEnvironment")
+	defer os.Unsetenv("ARM_CLIENT_SECRET")
+
+	cases := []struct {
+		Value    string
+		FilePath string
+		Expected string
+		Error    bool
+	}{
+		{
+			Value:    "inline",
+			Expected: "inline",
+		},
+		{
+			FilePath: validPath,
+			Expected: "s3cr3t",
+		},
+		{
+			Value:    "s3cr3t",
+			FilePath: validPath,
+			Expected: "s3cr3t",
+		},
+		{
+			Value:    "different",
+			FilePath: validPath,
+			Error:    true,
+		},
+		{
+			Value:    "fromEnvironment",
+			FilePath: validPath,
+			Expected: "s3cr3t",
+		},
+		{
+			FilePath: emptyPath,
+			Error:    true,
+		},
+		{
+			FilePath: filepath.Join(dir, "missing"),
+			Error:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		result, err := valueFromFilePath(tc.Value, tc.FilePath, "client_secret", "ARM_CLIENT_SECRET")
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("Expected an error for value %q and file path %q", tc.Value, tc.FilePath)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error for value %q and file path %q: %v", tc.Value, tc.FilePath, err)
+		}
+		if result != tc.Expected {
+			t.Fatalf("Expected %q, got %q for value %q and file path %q", tc.Expected, result, tc.Value, tc.FilePath)
+		}
+	}
+}
+
 func TestAccProvider_cliAuth(t *testing.T) {
 	if os.Getenv("TF_ACC") == "" {
 		return
@@ -48,7 +122,7 @@ func TestAccProvider_cliAuth(t *testing.T) {
 			EnableAzureCliToken: true,
 		}
 
-		return buildClient(ctx, provider, authConfig, "")
+		return buildClient(ctx, provider, authConfig, "", false)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -89,7 +163,7 @@ func TestAccProvider_clientCertificateAuth(t *testing.T) {
 			ClientCertPassword:   d.Get("client_certificate_password").(string),
 		}
 
-		return buildClient(ctx, provider, authConfig, "")
+		return buildClient(ctx, provider, authConfig, "", false)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -139,7 +213,7 @@ func TestAccProvider_clientCertificateInlineAuth(t *testing.T) {
 			ClientCertPassword:   d.Get("client_certificate_password").(string),
 		}
 
-		return buildClient(ctx, provider, authConfig, "")
+		return buildClient(ctx, provider, authConfig, "", false)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -179,7 +253,7 @@ func TestAccProvider_clientSecretAuth(t *testing.T) {
 			ClientSecret:           d.Get("client_secret").(string),
 		}
 
-		return buildClient(ctx, provider, authConfig, "")
+		return buildClient(ctx, provider, authConfig, "", false)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -194,6 +268,41 @@ func TestAccProvider_clientSecretAuth(t *testing.T) {
 	}
 }
 
+func TestProvider_skipCredentialValidation(t *testing.T) {
+	provider := AzureADProvider()
+	ctx := context.Background()
+
+	// Client secret credentials which cannot be used to obtain a token, since the token endpoint is unreachable
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		env := environments.Global
+		env.AzureADEndpoint = "https://login.invalid"
+
+		authConfig := &auth.Config{
+			Environment: env,
+			TenantID:    "00000000-0000-0000-0000-000000000000",
+			ClientID:    "11111111-1111-1111-1111-111111111111",
+
+			EnableClientSecretAuth: true,
+			ClientSecret:           "not-a-real-secret",
+		}
+
+		return buildClient(ctx, provider, authConfig, "", true)
+	}
+
+	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
+	if d != nil && d.HasError() {
+		t.Fatalf("expected provider to be configured without validating credentials, got: %+v", d)
+	}
+
+	client := provider.Meta().(*clients.Client)
+	if client.Claims.ObjectId != "" {
+		t.Fatalf("expected claims to be empty before an access token is acquired, got object ID %q", client.Claims.ObjectId)
+	}
+	if err := client.LoadClaims(); err == nil {
+		t.Fatalf("expected an error when acquiring an access token with invalid credentials")
+	}
+}
+
 func testCheckProvider(provider *schema.Provider) (errs []error) {
 	client := provider.Meta().(*clients.Client)
 
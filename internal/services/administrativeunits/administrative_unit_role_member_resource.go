@@ -0,0 +1,230 @@
+package administrativeunits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/administrativeunits/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func administrativeUnitRoleMemberResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: administrativeUnitRoleMemberResourceCreate,
+		ReadContext:   administrativeUnitRoleMemberResourceRead,
+		DeleteContext: administrativeUnitRoleMemberResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AdministrativeUnitRoleMemberID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"administrative_unit_object_id": {
+				Description:      "The object ID of the administrative unit",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"role_object_id": {
+				Description:      "The object ID of the directory role to assign, scoped to the administrative unit",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"member_object_id": {
+				Description:      "The object ID of the member being assigned the role",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func administrativeUnitRoleMemberResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitsClient
+	directoryRolesClient := meta.(*clients.Client).DirectoryRoles.DirectoryRolesClient
+	directoryRoleTemplatesClient := meta.(*clients.Client).DirectoryRoles.DirectoryRoleTemplatesClient
+
+	id := parse.NewAdministrativeUnitRoleMemberID(d.Get("administrative_unit_object_id").(string), d.Get("role_object_id").(string), d.Get("member_object_id").(string))
+
+	tf.LockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+	defer tf.UnlockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+
+	if _, status, err := client.Get(ctx, id.AdministrativeUnitId, odata.Query{}); err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "administrative_unit_object_id", "Administrative unit with object ID %q was not found", id.AdministrativeUnitId)
+		}
+		return tf.ErrorDiagPathF(err, "administrative_unit_object_id", "Retrieving administrative unit with object ID: %q", id.AdministrativeUnitId)
+	}
+
+	// Directory roles must be activated in the tenant before they can be assigned, so check that the role exists and
+	// give a useful hint when a role template ID has been supplied instead
+	directoryRolesClient.BaseClient.DisableRetries = true
+	if _, status, err := directoryRolesClient.Get(ctx, id.RoleId); err != nil {
+		if status == http.StatusNotFound {
+			directoryRoleTemplatesClient.BaseClient.DisableRetries = true
+			if template, _, err := directoryRoleTemplatesClient.Get(ctx, id.RoleId); err == nil && template != nil {
+				return tf.ErrorDiagPathF(nil, "role_object_id", "The value %q is a directory role template ID, but an activated directory role object ID is required. Activate the role first, e.g. using the `azuread_directory_role` resource, and specify its `object_id`", id.RoleId)
+			}
+			return tf.ErrorDiagPathF(nil, "role_object_id", "Directory role with object ID %q was not found. Directory roles must be activated before they can be assigned, e.g. using the `azuread_directory_role` resource", id.RoleId)
+		}
+		return tf.ErrorDiagPathF(err, "role_object_id", "Retrieving directory role with object ID: %q", id.RoleId)
+	}
+
+	client.BaseClient.DisableRetries = true
+	existing, _, err := administrativeUnitFindScopedRoleMember(ctx, client, id.AdministrativeUnitId, id.RoleId, id.MemberId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing scoped role membership for member %q and role %q in administrative unit %q", id.MemberId, id.RoleId, id.AdministrativeUnitId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_administrative_unit_role_member", id.String())
+	}
+	client.BaseClient.DisableRetries = false
+
+	properties := msgraph.ScopedRoleMembership{
+		AdministrativeUnitId: utils.String(id.AdministrativeUnitId),
+		RoleId:               utils.String(id.RoleId),
+		RoleMemberInfo: &msgraph.Identity{
+			Id: utils.String(id.MemberId),
+		},
+	}
+
+	if _, _, err := client.AddScopedRoleMember(ctx, id.AdministrativeUnitId, properties); err != nil {
+		return tf.ErrorDiagF(err, "Assigning role %q to member %q for administrative unit %q", id.RoleId, id.MemberId, id.AdministrativeUnitId)
+	}
+
+	// Wait for scoped role membership to reflect
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return tf.ErrorDiagF(errors.New("context has no deadline"), "Waiting for scoped role member %q to reflect for administrative unit %q", id.MemberId, id.AdministrativeUnitId)
+	}
+	timeout := time.Until(deadline)
+	_, err = (&resource.StateChangeConf{
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   timeout,
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Refresh: func() (interface{}, string, error) {
+			membership, _, err := administrativeUnitFindScopedRoleMember(ctx, client, id.AdministrativeUnitId, id.RoleId, id.MemberId)
+			if err != nil {
+				return nil, "Error", fmt.Errorf("retrieving scoped role member")
+			}
+			if membership == nil {
+				return "stub", "Waiting", nil
+			}
+			return "stub", "Done", nil
+		},
+	}).WaitForStateContext(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for scoped role member %q to reflect for administrative unit %q", id.MemberId, id.AdministrativeUnitId)
+	}
+
+	d.SetId(id.String())
+
+	return administrativeUnitRoleMemberResourceRead(ctx, d, meta)
+}
+
+func administrativeUnitRoleMemberResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitsClient
+
+	id, err := parse.AdministrativeUnitRoleMemberID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Administrative Unit Role Member ID %q", d.Id())
+	}
+
+	membership, status, err := administrativeUnitFindScopedRoleMember(ctx, client, id.AdministrativeUnitId, id.RoleId, id.MemberId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Administrative unit with ID %q was not found - removing scoped role member from state", id.AdministrativeUnitId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving scoped role member %q for administrative unit with object ID: %q", id.MemberId, id.AdministrativeUnitId)
+	}
+	if membership == nil {
+		log.Printf("[DEBUG] Scoped role member %q with role %q was not found in administrative unit %q - removing from state", id.MemberId, id.RoleId, id.AdministrativeUnitId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "administrative_unit_object_id", id.AdministrativeUnitId)
+	tf.Set(d, "member_object_id", id.MemberId)
+	tf.Set(d, "role_object_id", id.RoleId)
+
+	return nil
+}
+
+func administrativeUnitRoleMemberResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitsClient
+
+	id, err := parse.AdministrativeUnitRoleMemberID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Administrative Unit Role Member ID %q", d.Id())
+	}
+
+	tf.LockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+	defer tf.UnlockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+
+	membership, status, err := administrativeUnitFindScopedRoleMember(ctx, client, id.AdministrativeUnitId, id.RoleId, id.MemberId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving scoped role member %q for administrative unit with object ID: %q", id.MemberId, id.AdministrativeUnitId)
+	}
+	if membership == nil {
+		return nil
+	}
+	if membership.Id == nil {
+		return tf.ErrorDiagF(errors.New("API returned scoped role membership with nil ID"), "Bad API Response")
+	}
+
+	if status, err := client.RemoveScopedRoleMembers(ctx, id.AdministrativeUnitId, *membership.Id); err != nil && status != http.StatusNotFound {
+		return tf.ErrorDiagF(err, "Removing scoped role member %q from administrative unit with object ID: %q", id.MemberId, id.AdministrativeUnitId)
+	}
+
+	// Wait for scoped role membership to be deleted
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		client.BaseClient.DisableRetries = true
+		membership, status, err := administrativeUnitFindScopedRoleMember(ctx, client, id.AdministrativeUnitId, id.RoleId, id.MemberId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return utils.Bool(false), nil
+			}
+			return nil, err
+		}
+		return utils.Bool(membership != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of scoped role member %q from administrative unit with object ID %q", id.MemberId, id.AdministrativeUnitId)
+	}
+
+	return nil
+}
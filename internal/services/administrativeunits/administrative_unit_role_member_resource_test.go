@@ -0,0 +1,121 @@
+package administrativeunits_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/administrativeunits/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AdministrativeUnitRoleMemberResource struct{}
+
+func TestAccAdministrativeUnitRoleMember_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit_role_member", "test")
+	r := AdministrativeUnitRoleMemberResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrative_unit_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("member_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("role_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAdministrativeUnitRoleMember_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit_role_member", "test")
+	r := AdministrativeUnitRoleMemberResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r AdministrativeUnitRoleMemberResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.AdministrativeUnits.AdministrativeUnitsClient
+	client.BaseClient.DisableRetries = true
+
+	id, err := parse.AdministrativeUnitRoleMemberID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Administrative Unit Role Member ID: %v", err)
+	}
+
+	memberships, status, err := client.ListScopedRoleMembers(ctx, id.AdministrativeUnitId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve scoped role members for administrative unit %q: %+v", id.AdministrativeUnitId, err)
+	}
+
+	if memberships != nil {
+		for _, membership := range *memberships {
+			if membership.RoleId != nil && strings.EqualFold(*membership.RoleId, id.RoleId) &&
+				membership.RoleMemberInfo != nil && membership.RoleMemberInfo.Id != nil && strings.EqualFold(*membership.RoleMemberInfo.Id, id.MemberId) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r AdministrativeUnitRoleMemberResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Helpdesk administrator"
+}
+
+resource "azuread_administrative_unit_role_member" "test" {
+  administrative_unit_object_id = azuread_administrative_unit.test.object_id
+  role_object_id                = azuread_directory_role.test.object_id
+  member_object_id              = azuread_user.test.object_id
+}
+`, AdministrativeUnitResource{}.basic(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (r AdministrativeUnitRoleMemberResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_administrative_unit_role_member" "import" {
+  administrative_unit_object_id = azuread_administrative_unit_role_member.test.administrative_unit_object_id
+  role_object_id                = azuread_administrative_unit_role_member.test.role_object_id
+  member_object_id              = azuread_administrative_unit_role_member.test.member_object_id
+}
+`, r.basic(data))
+}
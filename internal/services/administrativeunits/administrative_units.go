@@ -3,6 +3,7 @@ package administrativeunits
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
@@ -28,3 +29,25 @@ func administrativeUnitFindByName(ctx context.Context, client *msgraph.Administr
 
 	return &result, nil
 }
+
+// administrativeUnitFindScopedRoleMember returns the scoped role membership for the specified role and member within
+// an administrative unit, or nil if no such membership exists.
+func administrativeUnitFindScopedRoleMember(ctx context.Context, client *msgraph.AdministrativeUnitsClient, administrativeUnitId, roleId, memberId string) (*msgraph.ScopedRoleMembership, int, error) {
+	memberships, status, err := client.ListScopedRoleMembers(ctx, administrativeUnitId, odata.Query{})
+	if err != nil {
+		return nil, status, fmt.Errorf("unable to list scoped role members for administrative unit %q: %+v", administrativeUnitId, err)
+	}
+
+	if memberships != nil {
+		for _, membership := range *memberships {
+			if membership.RoleId == nil || membership.RoleMemberInfo == nil || membership.RoleMemberInfo.Id == nil {
+				continue
+			}
+			if strings.EqualFold(*membership.RoleId, roleId) && strings.EqualFold(*membership.RoleMemberInfo.Id, memberId) {
+				return &membership, status, nil
+			}
+		}
+	}
+
+	return nil, status, nil
+}
@@ -0,0 +1,57 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type AdministrativeUnitRoleMemberId struct {
+	AdministrativeUnitId string
+	RoleId               string
+	MemberId             string
+}
+
+func NewAdministrativeUnitRoleMemberID(administrativeUnitId, roleId, memberId string) AdministrativeUnitRoleMemberId {
+	return AdministrativeUnitRoleMemberId{
+		AdministrativeUnitId: administrativeUnitId,
+		RoleId:               roleId,
+		MemberId:             memberId,
+	}
+}
+
+func (id AdministrativeUnitRoleMemberId) String() string {
+	return fmt.Sprintf("%s/roleMember/%s/%s", id.AdministrativeUnitId, id.RoleId, id.MemberId)
+}
+
+func AdministrativeUnitRoleMemberID(idString string) (*AdministrativeUnitRoleMemberId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("Administrative Unit Role Member ID should be in the format {administrativeUnitId}/roleMember/{roleId}/{memberId} - but got %q", idString)
+	}
+
+	if parts[1] != "roleMember" {
+		return nil, fmt.Errorf("Type in {administrativeUnitId}/{type}/{roleId}/{memberId} was expected to be roleMember, got %s", parts[1])
+	}
+
+	id := AdministrativeUnitRoleMemberId{
+		AdministrativeUnitId: parts[0],
+		RoleId:               parts[2],
+		MemberId:             parts[3],
+	}
+
+	if _, err := uuid.ParseUUID(id.AdministrativeUnitId); err != nil {
+		return nil, fmt.Errorf("Administrative Unit ID isn't a valid UUID (%q): %+v", id.AdministrativeUnitId, err)
+	}
+
+	if _, err := uuid.ParseUUID(id.RoleId); err != nil {
+		return nil, fmt.Errorf("Role ID isn't a valid UUID (%q): %+v", id.RoleId, err)
+	}
+
+	if _, err := uuid.ParseUUID(id.MemberId); err != nil {
+		return nil, fmt.Errorf("Member ID isn't a valid UUID (%q): %+v", id.MemberId, err)
+	}
+
+	return &id, nil
+}
@@ -28,7 +28,8 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_administrative_unit":        administrativeUnitResource(),
-		"azuread_administrative_unit_member": administrativeUnitMemberResource(),
+		"azuread_administrative_unit":             administrativeUnitResource(),
+		"azuread_administrative_unit_member":      administrativeUnitMemberResource(),
+		"azuread_administrative_unit_role_member": administrativeUnitRoleMemberResource(),
 	}
 }
@@ -0,0 +1,294 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func applicationApiPermissionsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: applicationApiPermissionsDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Description:      "The object ID of the application",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"application_object_id", "service_principal_object_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"service_principal_object_id": {
+				Description:      "The object ID of the service principal for the application",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"application_object_id", "service_principal_object_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"application_id": {
+				Description: "The application ID (client ID) of the application",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"all_granted": {
+				Description: "Whether all the API permissions declared by the application have been granted",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"permissions": {
+				Description: "The API permissions declared by the application, along with their grant status",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_app_id": {
+							Description: "The application ID (client ID) of the resource application",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"resource_object_id": {
+							Description: "The object ID of the service principal for the resource application",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"id": {
+							Description: "The unique identifier of the app role or permission scope",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "Whether the permission is an app role (`Role`) or a permission scope (`Scope`)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"value": {
+							Description: "The value of the app role or permission scope, as published by the resource application",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"granted": {
+							Description: "Whether the permission has been granted, i.e. assigned for an app role, or consented for all principals for a permission scope",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applicationApiPermissionsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	appRoleAssignmentsClient := meta.(*clients.Client).Applications.AppRoleAssignmentsClient
+	delegatedPermissionGrantsClient := meta.(*clients.Client).Applications.DelegatedPermissionGrantsClient
+	servicePrincipalsClient := meta.(*clients.Client).Applications.ServicePrincipalsClient
+	client.BaseClient.DisableRetries = true
+	servicePrincipalsClient.BaseClient.DisableRetries = true
+
+	var app *msgraph.Application
+	var servicePrincipal *msgraph.ServicePrincipal
+
+	if objectId := d.Get("application_object_id").(string); objectId != "" {
+		var status int
+		var err error
+		app, status, err = client.Get(ctx, objectId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", objectId)
+			}
+			return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", objectId)
+		}
+		if app == nil || app.AppId == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil application or application with nil application ID"), "Bad API Response")
+		}
+
+		servicePrincipal, err = applicationFindServicePrincipal(ctx, servicePrincipalsClient, *app.AppId)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve service principal for application with object ID %q", objectId)
+		}
+	} else {
+		objectId := d.Get("service_principal_object_id").(string)
+		var status int
+		var err error
+		servicePrincipal, status, err = servicePrincipalsClient.Get(ctx, objectId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "service_principal_object_id", "Service principal with object ID %q was not found", objectId)
+			}
+			return tf.ErrorDiagPathF(err, "service_principal_object_id", "Retrieving service principal with object ID %q", objectId)
+		}
+		if servicePrincipal == nil || servicePrincipal.AppId == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil service principal or service principal with nil application ID"), "Bad API Response")
+		}
+
+		query := odata.Query{Filter: fmt.Sprintf("appId eq '%s'", *servicePrincipal.AppId)}
+		result, _, err := client.List(ctx, query)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing applications with filter %q", query.Filter)
+		}
+		if result == nil || len(*result) == 0 {
+			return tf.ErrorDiagPathF(nil, "service_principal_object_id", "No application was found in this tenant for the service principal with object ID %q", objectId)
+		}
+		app = &(*result)[0]
+	}
+
+	if app.ID == nil {
+		return tf.ErrorDiagF(errors.New("API returned application with nil object ID"), "Bad API Response")
+	}
+
+	// Build lookups of assigned app roles and consented permission scopes for the client service principal
+	assignedAppRoles := make(map[string]bool)
+	consentedScopes := make(map[string]bool)
+	servicePrincipalId := ""
+
+	if servicePrincipal != nil && servicePrincipal.ID != nil {
+		servicePrincipalId = *servicePrincipal.ID
+
+		appRoleAssignments, _, err := appRoleAssignmentsClient.List(ctx, servicePrincipalId)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not list app role assignments for service principal with object ID %q", servicePrincipalId)
+		}
+		if appRoleAssignments != nil {
+			for _, assignment := range *appRoleAssignments {
+				if assignment.ResourceId != nil && assignment.AppRoleId != nil {
+					assignedAppRoles[applicationApiPermissionKey(*assignment.ResourceId, *assignment.AppRoleId)] = true
+				}
+			}
+		}
+
+		query := odata.Query{Filter: fmt.Sprintf("clientId eq '%s'", servicePrincipalId)}
+		grants, _, err := delegatedPermissionGrantsClient.List(ctx, query)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not list delegated permission grants for service principal with object ID %q", servicePrincipalId)
+		}
+		if grants != nil {
+			for _, grant := range *grants {
+				if grant.ResourceId == nil || grant.Scopes == nil {
+					continue
+				}
+				if grant.ConsentType == nil || *grant.ConsentType != msgraph.DelegatedPermissionGrantConsentTypeAllPrincipals {
+					continue
+				}
+				for _, scope := range *grant.Scopes {
+					consentedScopes[applicationApiPermissionKey(*grant.ResourceId, scope)] = true
+				}
+			}
+		}
+	}
+
+	allGranted := true
+	permissions := make([]interface{}, 0)
+	resourceServicePrincipals := make(map[string]*msgraph.ServicePrincipal)
+
+	if app.RequiredResourceAccess != nil {
+		for _, rra := range *app.RequiredResourceAccess {
+			if rra.ResourceAppId == nil || rra.ResourceAccess == nil {
+				continue
+			}
+			resourceAppId := *rra.ResourceAppId
+
+			resourceServicePrincipal, ok := resourceServicePrincipals[resourceAppId]
+			if !ok {
+				var err error
+				resourceServicePrincipal, err = applicationFindServicePrincipal(ctx, servicePrincipalsClient, resourceAppId)
+				if err != nil {
+					return tf.ErrorDiagF(err, "Could not retrieve service principal for resource application with application ID %q", resourceAppId)
+				}
+				resourceServicePrincipals[resourceAppId] = resourceServicePrincipal
+			}
+
+			resourceObjectId := ""
+			if resourceServicePrincipal != nil && resourceServicePrincipal.ID != nil {
+				resourceObjectId = *resourceServicePrincipal.ID
+			}
+
+			for _, access := range *rra.ResourceAccess {
+				if access.ID == nil {
+					continue
+				}
+
+				value := ""
+				granted := false
+
+				switch access.Type {
+				case msgraph.ResourceAccessTypeRole:
+					if resourceServicePrincipal != nil && resourceServicePrincipal.AppRoles != nil {
+						for _, role := range *resourceServicePrincipal.AppRoles {
+							if role.ID != nil && strings.EqualFold(*role.ID, *access.ID) && role.Value != nil {
+								value = *role.Value
+								break
+							}
+						}
+					}
+					granted = resourceObjectId != "" && assignedAppRoles[applicationApiPermissionKey(resourceObjectId, *access.ID)]
+
+				case msgraph.ResourceAccessTypeScope:
+					if resourceServicePrincipal != nil && resourceServicePrincipal.PublishedPermissionScopes != nil {
+						for _, scope := range *resourceServicePrincipal.PublishedPermissionScopes {
+							if scope.ID != nil && strings.EqualFold(*scope.ID, *access.ID) && scope.Value != nil {
+								value = *scope.Value
+								break
+							}
+						}
+					}
+					granted = resourceObjectId != "" && value != "" && consentedScopes[applicationApiPermissionKey(resourceObjectId, value)]
+				}
+
+				if !granted {
+					allGranted = false
+				}
+
+				permissions = append(permissions, map[string]interface{}{
+					"resource_app_id":    resourceAppId,
+					"resource_object_id": resourceObjectId,
+					"id":                 *access.ID,
+					"type":               access.Type,
+					"value":              value,
+					"granted":            granted,
+				})
+			}
+		}
+	}
+
+	d.SetId(*app.ID)
+
+	tf.Set(d, "all_granted", allGranted)
+	tf.Set(d, "application_id", app.AppId)
+	tf.Set(d, "application_object_id", app.ID)
+	tf.Set(d, "permissions", permissions)
+	tf.Set(d, "service_principal_object_id", servicePrincipalId)
+
+	return nil
+}
+
+func applicationApiPermissionKey(resourceObjectId, permission string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", resourceObjectId, permission))
+}
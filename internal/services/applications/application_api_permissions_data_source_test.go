@@ -0,0 +1,124 @@
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationApiPermissionsDataSource struct{}
+
+func TestAccApplicationApiPermissionsDataSource_notGranted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_api_permissions", "test")
+	r := ApplicationApiPermissionsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.notGranted(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_id").IsUuid(),
+				check.That(data.ResourceName).Key("service_principal_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("all_granted").HasValue("false"),
+				check.That(data.ResourceName).Key("permissions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("permissions.0.granted").HasValue("false"),
+				check.That(data.ResourceName).Key("permissions.1.granted").HasValue("false"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationApiPermissionsDataSource_granted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_api_permissions", "test")
+	r := ApplicationApiPermissionsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.granted(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("all_granted").HasValue("true"),
+				check.That(data.ResourceName).Key("permissions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("permissions.0.granted").HasValue("true"),
+				check.That(data.ResourceName).Key("permissions.1.granted").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (ApplicationApiPermissionsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_application_published_app_ids" "well_known" {}
+
+resource "azuread_service_principal" "msgraph" {
+  application_id = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+  use_existing   = true
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationApiPermissionsDataSource) notGranted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_application_api_permissions" "test" {
+  application_object_id = azuread_application.test.object_id
+
+  depends_on = [azuread_service_principal.test]
+}
+`, r.template(data))
+}
+
+func (r ApplicationApiPermissionsDataSource) granted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_role_assignment" "test" {
+  app_role_id         = azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+  principal_object_id = azuread_service_principal.test.object_id
+  resource_object_id  = azuread_service_principal.msgraph.object_id
+}
+
+resource "azuread_service_principal_delegated_permission_grant" "test" {
+  service_principal_object_id          = azuread_service_principal.test.object_id
+  resource_service_principal_object_id = azuread_service_principal.msgraph.object_id
+  claim_values                         = ["User.Read"]
+}
+
+data "azuread_application_api_permissions" "test" {
+  service_principal_object_id = azuread_service_principal.test.object_id
+
+  depends_on = [
+    azuread_app_role_assignment.test,
+    azuread_service_principal_delegated_permission_grant.test,
+  ]
+}
+`, r.template(data))
+}
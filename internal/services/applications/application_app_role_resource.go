@@ -0,0 +1,326 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	applicationsValidate "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/validate"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func applicationAppRoleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationAppRoleResourceCreate,
+		ReadContext:   applicationAppRoleResourceRead,
+		UpdateContext: applicationAppRoleResourceUpdate,
+		DeleteContext: applicationAppRoleResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AppRoleID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Description:      "The object ID of the application for which this app role should be created",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"role_id": {
+				Description:      "The unique identifier of the app role",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"allowed_member_types": {
+				Description: "Specifies whether this app role definition can be assigned to users and groups by setting to `User`, or to other applications (that are accessing this application in a standalone scenario) by setting to `Application`, or to both",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							msgraph.AppRoleAllowedMemberTypeApplication,
+							msgraph.AppRoleAllowedMemberTypeUser,
+						}, false,
+					),
+				},
+			},
+
+			"description": {
+				Description:      "Description of the app role that appears when the role is being assigned and, if the role functions as an application permissions, during the consent experiences",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"display_name": {
+				Description:      "Display name for the app role that appears during app role assignment and in consent experiences",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"enabled": {
+				Description: "Determines if the app role is enabled",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			"value": {
+				Description:      "The value that is used for the `roles` claim in ID tokens and OAuth 2.0 access tokens that are authenticating an assigned service or user principal",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: applicationsValidate.RoleScopeClaimValue,
+			},
+		},
+	}
+}
+
+func applicationAppRoleResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	id := parse.NewAppRoleID(d.Get("application_object_id").(string), d.Get("role_id").(string))
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", id.ObjectId)
+	}
+
+	newRoles := make([]msgraph.AppRole, 0)
+	if app.AppRoles != nil {
+		for _, role := range *app.AppRoles {
+			if role.ID != nil && strings.EqualFold(*role.ID, id.RoleId) {
+				return tf.ImportAsExistsDiag("azuread_application_app_role", id.String())
+			}
+			newRoles = append(newRoles, role)
+		}
+	}
+
+	newRoles = append(newRoles, expandApplicationAppRole(d, id.RoleId))
+
+	properties := msgraph.Application{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: app.ID,
+		},
+		AppRoles: &newRoles,
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ODataErrorDiagF(err, "Adding app role %q for application with object ID %q", id.RoleId, id.ObjectId)
+	}
+
+	d.SetId(id.String())
+
+	return applicationAppRoleResourceRead(ctx, d, meta)
+}
+
+func applicationAppRoleResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	id, err := parse.AppRoleID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing app role ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", id.ObjectId)
+	}
+
+	found := false
+	newRoles := make([]msgraph.AppRole, 0)
+	if app.AppRoles != nil {
+		for _, role := range *app.AppRoles {
+			if role.ID != nil && strings.EqualFold(*role.ID, id.RoleId) {
+				role = expandApplicationAppRole(d, id.RoleId)
+				found = true
+			}
+			newRoles = append(newRoles, role)
+		}
+	}
+	if !found {
+		return tf.ErrorDiagPathF(nil, "role_id", "App role %q was not found for application with object ID %q", id.RoleId, id.ObjectId)
+	}
+
+	// An enabled app role must be disabled before it can be changed
+	if err := applicationDisableAppRoles(ctx, client, app, &newRoles); err != nil {
+		return tf.ErrorDiagPathF(err, "enabled", "Could not disable app role %q for application with object ID %q", id.RoleId, id.ObjectId)
+	}
+
+	properties := msgraph.Application{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: app.ID,
+		},
+		AppRoles: &newRoles,
+	}
+
+	if err := applicationUpdateAfterDisabling(ctx, client, properties); err != nil {
+		return tf.ODataErrorDiagF(err, "Updating app role %q for application with object ID %q", id.RoleId, id.ObjectId)
+	}
+
+	return applicationAppRoleResourceRead(ctx, d, meta)
+}
+
+func applicationAppRoleResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	id, err := parse.AppRoleID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing app role ID %q", d.Id())
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with ID %q for app role %q was not found - removing from state!", id.ObjectId, id.RoleId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", id.ObjectId)
+	}
+
+	var role *msgraph.AppRole
+	if app.AppRoles != nil {
+		for _, r := range *app.AppRoles {
+			if r.ID != nil && strings.EqualFold(*r.ID, id.RoleId) {
+				role = &r
+				break
+			}
+		}
+	}
+	if role == nil {
+		log.Printf("[DEBUG] No matching app role for ID %q - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	allowedMemberTypes := make([]string, 0)
+	if role.AllowedMemberTypes != nil {
+		for _, memberType := range *role.AllowedMemberTypes {
+			allowedMemberTypes = append(allowedMemberTypes, memberType)
+		}
+	}
+
+	tf.Set(d, "allowed_member_types", allowedMemberTypes)
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "description", role.Description)
+	tf.Set(d, "display_name", role.DisplayName)
+	tf.Set(d, "enabled", role.IsEnabled)
+	tf.Set(d, "role_id", id.RoleId)
+	tf.Set(d, "value", role.Value)
+
+	return nil
+}
+
+func applicationAppRoleResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	id, err := parse.AppRoleID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing app role ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with ID %q for app role %q was not found - removing from state!", id.ObjectId, id.RoleId)
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", id.ObjectId)
+	}
+
+	newRoles := make([]msgraph.AppRole, 0)
+	if app.AppRoles != nil {
+		for _, role := range *app.AppRoles {
+			if role.ID == nil || !strings.EqualFold(*role.ID, id.RoleId) {
+				newRoles = append(newRoles, role)
+			}
+		}
+	}
+
+	// The app role must be disabled before it can be removed
+	if err := applicationDisableAppRoles(ctx, client, app, &newRoles); err != nil {
+		return tf.ErrorDiagF(err, "Could not disable app role %q for application with object ID %q", id.RoleId, id.ObjectId)
+	}
+
+	properties := msgraph.Application{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: app.ID,
+		},
+		AppRoles: &newRoles,
+	}
+
+	if err := applicationUpdateAfterDisabling(ctx, client, properties); err != nil {
+		return tf.ODataErrorDiagF(err, "Removing app role %q from application with object ID %q", id.RoleId, id.ObjectId)
+	}
+
+	return nil
+}
+
+func expandApplicationAppRole(d *schema.ResourceData, roleId string) msgraph.AppRole {
+	allowedMemberTypes := make([]msgraph.AppRoleAllowedMemberType, 0)
+	for _, memberType := range d.Get("allowed_member_types").(*schema.Set).List() {
+		allowedMemberTypes = append(allowedMemberTypes, memberType.(string))
+	}
+
+	return msgraph.AppRole{
+		ID:                 utils.String(roleId),
+		AllowedMemberTypes: &allowedMemberTypes,
+		Description:        utils.String(d.Get("description").(string)),
+		DisplayName:        utils.String(d.Get("display_name").(string)),
+		IsEnabled:          utils.Bool(d.Get("enabled").(bool)),
+		Value:              utils.String(d.Get("value").(string)),
+	}
+}
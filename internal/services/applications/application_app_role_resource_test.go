@@ -0,0 +1,218 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationAppRoleResource struct{}
+
+func TestAccApplicationAppRole_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_app_role", "test")
+	r := ApplicationAppRoleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_id").IsUuid(),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationAppRole_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_app_role", "test")
+	r := ApplicationAppRoleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allowed_member_types.#").HasValue("2"),
+				check.That(data.ResourceName).Key("value").HasValue("Admin.ReadWrite"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationAppRole_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_app_role", "test")
+	r := ApplicationAppRoleResource{}
+	secondRoleId := data.UUID()
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multiple(data, secondRoleId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_application_app_role.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// The second app role is disabled and then removed, leaving the first intact
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationAppRole_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_app_role", "test")
+	r := ApplicationAppRoleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (ApplicationAppRoleResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationsClient
+	client.BaseClient.DisableRetries = true
+
+	id, err := parse.AppRoleID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing App Role ID: %v", err)
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Application with object ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	if app.AppRoles != nil {
+		for _, role := range *app.AppRoles {
+			if role.ID != nil && strings.EqualFold(*role.ID, id.RoleId) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("App Role %q was not found for Application %q", id.RoleId, id.ObjectId)
+}
+
+func (ApplicationAppRoleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestApp-%[1]d"
+
+  lifecycle {
+    ignore_changes = [app_role]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationAppRoleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_app_role" "test" {
+  application_object_id = azuread_application.test.object_id
+  role_id               = "%[2]s"
+  allowed_member_types  = ["User"]
+  description           = "Admins can manage roles and perform all task actions"
+  display_name          = "Admin"
+  value                 = "Admin"
+}
+`, r.template(data), data.RandomID)
+}
+
+func (r ApplicationAppRoleResource) complete(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_app_role" "test" {
+  application_object_id = azuread_application.test.object_id
+  role_id               = "%[2]s"
+  allowed_member_types  = ["User", "Application"]
+  description           = "Admins can read and write all data"
+  display_name          = "Admin Read/Write"
+  enabled               = %[3]t
+  value                 = "Admin.ReadWrite"
+}
+`, r.template(data), data.RandomID, enabled)
+}
+
+func (r ApplicationAppRoleResource) multiple(data acceptance.TestData, secondRoleId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_app_role" "second" {
+  application_object_id = azuread_application.test.object_id
+  role_id               = "%[2]s"
+  allowed_member_types  = ["Application"]
+  description           = "Applications can read all data"
+  display_name          = "Reader"
+  value                 = "Data.Read.All"
+}
+`, r.basic(data), secondRoleId)
+}
+
+func (r ApplicationAppRoleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_app_role" "import" {
+  application_object_id = azuread_application_app_role.test.application_object_id
+  role_id               = azuread_application_app_role.test.role_id
+  allowed_member_types  = ["User"]
+  description           = "Admins can manage roles and perform all task actions"
+  display_name          = "Admin"
+  value                 = "Admin"
+}
+`, r.basic(data))
+}
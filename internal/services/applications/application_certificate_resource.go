@@ -18,6 +18,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	applicationsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
@@ -93,7 +94,7 @@ func applicationCertificateResource() *schema.Resource {
 			},
 
 			"end_date_relative": {
-				Description:      "A relative duration for which the certificate is valid until, for example `240h` (10 days) or `2400h30m`",
+				Description:      "A relative duration for which the certificate is valid until, for example `240h` (10 days), `2400h30m`, `90d` or `1y`",
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
@@ -112,6 +113,14 @@ func applicationCertificateResource() *schema.Resource {
 				}, false),
 			},
 
+			"use_for_token_encryption": {
+				Description: "Whether this certificate should be used by Azure AD to encrypt emitted tokens. When `true`, the application's token encryption key ID is pointed at this certificate in the same request that adds it",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+
 			"value": {
 				Description: "The certificate data, which can be PEM encoded, base64 encoded DER or hexadecimal encoded DER. See also the `encoding` argumen",
 				Type:        schema.TypeString,
@@ -170,6 +179,13 @@ func applicationCertificateResourceCreate(ctx context.Context, d *schema.Resourc
 		},
 		KeyCredentials: &newCredentials,
 	}
+
+	// Existing certificates are retained, and the token encryption key is repointed in the same request, so that when
+	// rolling over with `create_before_destroy` there is no point at which the application lacks a valid certificate
+	if d.Get("use_for_token_encryption").(bool) {
+		properties.TokenEncryptionKeyId = utils.String(id.KeyId)
+	}
+
 	if _, err := client.Update(ctx, properties); err != nil {
 		return tf.ErrorDiagF(err, "Adding certificate for application with object ID %q", id.ObjectId)
 	}
@@ -274,6 +290,21 @@ func applicationCertificateResourceDelete(ctx context.Context, d *schema.Resourc
 		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
 	}
 
+	// A certificate cannot be removed whilst it is used for token encryption, so the token encryption key is cleared
+	// when it was set by this resource, otherwise the practitioner must repoint it to another certificate first
+	if app.TokenEncryptionKeyId != nil && strings.EqualFold(*app.TokenEncryptionKeyId, id.KeyId) {
+		if !d.Get("use_for_token_encryption").(bool) {
+			return tf.ErrorDiagF(errors.New("certificate is in use for token encryption"), "Refusing to remove certificate credential %q from application with object ID %q, because it is the application's token encryption key. Update `token_encryption_key_id` to reference another certificate first, or set `use_for_token_encryption` on the replacement certificate and use the `create_before_destroy` lifecycle option", id.KeyId, id.ObjectId)
+		}
+
+		samlSettings := applicationsClient.ApplicationSamlSettings{
+			TokenEncryptionKeyId: utils.NullableString(""),
+		}
+		if _, err := meta.(*clients.Client).Applications.ApplicationSamlSettingsClient.Update(ctx, id.ObjectId, samlSettings); err != nil {
+			return tf.ErrorDiagF(err, "Clearing token encryption key for application with object ID %q", id.ObjectId)
+		}
+	}
+
 	newCredentials := make([]msgraph.KeyCredential, 0)
 	if app.KeyCredentials != nil {
 		for _, cred := range *app.KeyCredentials {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -43,6 +44,29 @@ lab0Ty/kRC13JgNhHtNFwYVwK6NDt46IRsjxqWQ6bVakrEROlfuoY8sxUjunj+hB
 0/0Ayfjh6JllWqW482dIIqMErl6s5DuK
 -----END CERTIFICATE-----`
 
+// A second certificate, used to test rolling over from one certificate to another
+const applicationCertificateRolloverPem string = `-----BEGIN CERTIFICATE-----
+MIIDezCCAmOgAwIBAgIUAy7/RycuEHsZkQ7jX1OX9m6VomEwDQYJKoZIhvcNAQEL
+BQAwTTEXMBUGA1UEAwwOaGFzaGljb3JwdGVzdDIxGDAWBgNVBAoMD0hhc2hpQ29y
+cCwgSW5jLjELMAkGA1UECAwCQ0ExCzAJBgNVBAYTAlVTMB4XDTI2MTAxNjEyMTYx
+M1oXDTM2MTAxMzEyMTYxM1owTTEXMBUGA1UEAwwOaGFzaGljb3JwdGVzdDIxGDAW
+BgNVBAoMD0hhc2hpQ29ycCwgSW5jLjELMAkGA1UECAwCQ0ExCzAJBgNVBAYTAlVT
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAvHBy9VwZtxNcTsg66DMD
+rJaJ3Fxe0j5aO5rYqXCSAhdnQSjkEoZvriIAUYd/SUXGREwP70XECuVYPMFnf2vr
+FoUKnry8RFJ09Ruh3+JnbyYWHUIgJ1fKaOXpnuGtKeynH72oFzNY0ObyIamiYaHn
+rvU9vtmoA9hxYduFcxNg6iZdB+zkoA7fEKPPH3UWR4X/JoE/FKyr2/JDaiwSnV3s
+vAM4JEQx7YOhVupwXnWdC8pwnDImrJ9E/RTh7IcDmMq8NR/krnJxZed4fAu24EsJ
+7bbY0cVFDiVewasbzpiFYt+PmS6ayoIRM6ZCONN4W5N2pSWbujlkz4INWSzk1Mss
+FwIDAQABo1MwUTAdBgNVHQ4EFgQUNNn1kzljxc6itbVJod5kSyZZ4EowHwYDVR0j
+BBgwFoAUNNn1kzljxc6itbVJod5kSyZZ4EowDwYDVR0TAQH/BAUwAwEB/zANBgkq
+hkiG9w0BAQsFAAOCAQEAH0eGEkrQnU1FLgrbe8Ucg6cCYpvupKGszUrK0BPuPT2c
+WtcmSier2PhVHecbZH9vfG5QEe/CZEwSJVX65zhTraSHR29VUhbm0ZgqYsTFU3Gm
+cfSFIse9KYmMmesdVoFOCJK9uJHzLbZKS2ZHsGrsb2vpnZFSOBv7OaFZyIWywLLc
+qyd34TbNG7mz08Zw9dmkhbUStYrNbKcv5Q0vuZiS3TjYhYFs0n4Ka/4QOj6S6taL
+hiTjsIgMVl7iWOBtahdd7XgAxt1UoaVFWhqVfAkT9hOuBpgk6FqA6thUcj0N9pCv
++c6sK82v2viLr7trUPj/kuKTZtTWxAdpVuD1Nat9vA==
+-----END CERTIFICATE-----`
+
 const applicationCertificateBase64 string = `MIIDFDCCAfwCCQCvHp+vopfOOTANBgkqhkiG9w0BAQsFADBMMRYwFAYDVQQD
 DA1oYXNoaWNvcnB0ZXN0MRgwFgYDVQQKDA9IYXNoaUNvcnAsIEluYy4xCzAJ
 BgNVBAgMAkNBMQswCQYDVQQGEwJVUzAeFw0yMTAzMDkxMTAyMTNaFw0zMTAz
@@ -79,7 +103,7 @@ func TestAccApplicationCertificate_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("key_id").Exists(),
 			),
 		},
-		data.ImportStep("encoding", "end_date_relative", "value"),
+		data.ImportStep("encoding", "end_date_relative", "use_for_token_encryption", "value"),
 	})
 }
 
@@ -97,7 +121,7 @@ func TestAccApplicationCertificate_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("key_id").Exists(),
 			),
 		},
-		data.ImportStep("encoding", "end_date_relative", "value"),
+		data.ImportStep("encoding", "end_date_relative", "use_for_token_encryption", "value"),
 	})
 }
 
@@ -114,7 +138,7 @@ func TestAccApplicationCertificate_base64Cert(t *testing.T) {
 				check.That(data.ResourceName).Key("key_id").Exists(),
 			),
 		},
-		data.ImportStep("encoding", "end_date_relative", "value"),
+		data.ImportStep("encoding", "end_date_relative", "use_for_token_encryption", "value"),
 	})
 }
 
@@ -131,7 +155,7 @@ func TestAccApplicationCertificate_hexCert(t *testing.T) {
 				check.That(data.ResourceName).Key("key_id").Exists(),
 			),
 		},
-		data.ImportStep("encoding", "end_date_relative", "value"),
+		data.ImportStep("encoding", "end_date_relative", "use_for_token_encryption", "value"),
 	})
 }
 
@@ -148,7 +172,7 @@ func TestAccApplicationCertificate_relativeEndDate(t *testing.T) {
 				check.That(data.ResourceName).Key("end_date").Exists(),
 			),
 		},
-		data.ImportStep("encoding", "end_date_relative", "value"),
+		data.ImportStep("encoding", "end_date_relative", "use_for_token_encryption", "value"),
 	})
 }
 
@@ -169,6 +193,32 @@ func TestAccApplicationCertificate_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_rollover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	r := ApplicationCertificateResource{}
+
+	var firstKeyId, secondKeyId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.rollover(data, applicationCertificatePem),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.keyId(data.ResourceName, &firstKeyId),
+			),
+		},
+		{
+			Config: r.rollover(data, applicationCertificateRolloverPem),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.keyId(data.ResourceName, &secondKeyId),
+				r.rolledOver(&firstKeyId, &secondKeyId),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "use_for_token_encryption", "value"),
+	})
+}
+
 func (ApplicationCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Applications.ApplicationsClient
 	client.BaseClient.DisableRetries = true
@@ -197,6 +247,52 @@ func (ApplicationCertificateResource) Exists(ctx context.Context, clients *clien
 	return nil, fmt.Errorf("Key Credential %q was not found for Application %q", id.KeyId, id.ObjectId)
 }
 
+func (ApplicationCertificateResource) keyId(resourceName string, keyId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+		*keyId = rs.Primary.Attributes["key_id"]
+		return nil
+	}
+}
+
+// rolledOver checks that the replacement certificate is the application's only key credential and that the token
+// encryption key was repointed to it, i.e. that the new certificate was added before the old one was removed
+func (ApplicationCertificateResource) rolledOver(oldKeyId, newKeyId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Applications.ApplicationsClient
+
+		rs, ok := s.RootModule().Resources["azuread_application.test"]
+		if !ok {
+			return fmt.Errorf("azuread_application.test was not found in the state")
+		}
+
+		if *oldKeyId == *newKeyId {
+			return fmt.Errorf("expected certificate to be replaced, but key ID %q was not changed", *newKeyId)
+		}
+
+		app, _, err := client.Get(clients.StopContext, rs.Primary.ID, odata.Query{})
+		if err != nil {
+			return fmt.Errorf("retrieving application with object ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		if app.KeyCredentials == nil || len(*app.KeyCredentials) != 1 {
+			return fmt.Errorf("expected application to have exactly 1 key credential after rollover")
+		}
+		if cred := (*app.KeyCredentials)[0]; cred.KeyId == nil || !strings.EqualFold(*cred.KeyId, *newKeyId) {
+			return fmt.Errorf("expected remaining key credential to be the replacement certificate %q", *newKeyId)
+		}
+		if app.TokenEncryptionKeyId == nil || !strings.EqualFold(*app.TokenEncryptionKeyId, *newKeyId) {
+			return fmt.Errorf("expected token encryption key ID to be repointed to the replacement certificate %q", *newKeyId)
+		}
+
+		return nil
+	}
+}
+
 func (ApplicationCertificateResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -298,3 +394,25 @@ resource "azuread_application_certificate" "import" {
 }
 `, r.basic(data, endDate))
 }
+
+func (r ApplicationCertificateResource) rollover(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_certificate" "test" {
+  application_object_id    = azuread_application.test.id
+  type                     = "AsymmetricX509Cert"
+  end_date_relative        = "2160h"
+  use_for_token_encryption = true
+  value                    = <<EOT
+%[2]s
+EOT
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, data.RandomInteger, value)
+}
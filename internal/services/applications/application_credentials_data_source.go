@@ -0,0 +1,112 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func applicationCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: applicationCredentialsDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Description:      "The object ID of the application",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"application_object_id", "service_principal_object_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"service_principal_object_id": {
+				Description:      "The object ID of the service principal",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"application_object_id", "service_principal_object_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"key_credentials": {
+				Description: "The certificates associated with the application or service principal",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        schemaCredentialMetadata("certificate"),
+			},
+
+			"password_credentials": {
+				Description: "The passwords (client secrets) associated with the application or service principal",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        schemaCredentialMetadata("password"),
+			},
+		},
+	}
+}
+
+func applicationCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	servicePrincipalsClient := meta.(*clients.Client).Applications.ServicePrincipalsClient
+	client.BaseClient.DisableRetries = true
+	servicePrincipalsClient.BaseClient.DisableRetries = true
+
+	var objectId string
+	var keyCredentials *[]msgraph.KeyCredential
+	var passwordCredentials *[]msgraph.PasswordCredential
+
+	if applicationId := d.Get("application_object_id").(string); applicationId != "" {
+		app, status, err := client.Get(ctx, applicationId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", applicationId)
+			}
+			return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", applicationId)
+		}
+		if app == nil || app.ID == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil application or application with nil object ID"), "Bad API Response")
+		}
+
+		objectId = *app.ID
+		keyCredentials = app.KeyCredentials
+		passwordCredentials = app.PasswordCredentials
+	} else {
+		servicePrincipalId := d.Get("service_principal_object_id").(string)
+		servicePrincipal, status, err := servicePrincipalsClient.Get(ctx, servicePrincipalId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "service_principal_object_id", "Service principal with object ID %q was not found", servicePrincipalId)
+			}
+			return tf.ErrorDiagPathF(err, "service_principal_object_id", "Retrieving service principal with object ID %q", servicePrincipalId)
+		}
+		if servicePrincipal == nil || servicePrincipal.ID == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil service principal or service principal with nil object ID"), "Bad API Response")
+		}
+
+		objectId = *servicePrincipal.ID
+		keyCredentials = servicePrincipal.KeyCredentials
+		passwordCredentials = servicePrincipal.PasswordCredentials
+	}
+
+	passwords := flattenApplicationPasswordCredentialsMetadata(passwordCredentials)
+
+	d.SetId(objectId)
+
+	tf.Set(d, "key_credentials", flattenApplicationKeyCredentialsMetadata(keyCredentials))
+	tf.Set(d, "password_credentials", passwords)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationCredentialsDataSource struct{}
+
+func TestAccApplicationCredentialsDataSource_application(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_credentials", "test")
+	r := ApplicationCredentialsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.application(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("key_credentials.#").HasValue("0"),
+				check.That(data.ResourceName).Key("password_credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("password_credentials.0.key_id").IsUuid(),
+				check.That(data.ResourceName).Key("password_credentials.0.display_name").HasValue(fmt.Sprintf("acctest-APP-password-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("password_credentials.0.start_date").Exists(),
+				check.That(data.ResourceName).Key("password_credentials.0.end_date").HasValue("2099-01-01T01:02:03Z"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationCredentialsDataSource_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_credentials", "test")
+	r := ApplicationCredentialsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("password_credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("password_credentials.0.key_id").IsUuid(),
+				check.That(data.ResourceName).Key("password_credentials.0.end_date").Exists(),
+			),
+		},
+	})
+}
+
+func (ApplicationCredentialsDataSource) application(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+}
+
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application.test.object_id
+  display_name          = "acctest-APP-password-%[1]d"
+  end_date              = "2099-01-01T01:02:03Z"
+}
+
+data "azuread_application_credentials" "test" {
+  application_object_id = azuread_application_password.test.application_object_id
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationCredentialsDataSource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_service_principal_password" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+}
+
+data "azuread_application_credentials" "test" {
+  service_principal_object_id = azuread_service_principal_password.test.service_principal_id
+}
+`, data.RandomInteger)
+}
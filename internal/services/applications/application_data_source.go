@@ -267,6 +267,13 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
+			"key_credentials": {
+				Description: "The certificates associated with the application, excluding any key material",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        schemaCredentialMetadata("certificate"),
+			},
+
 			"logo_url": {
 				Description: "CDN URL to the application's logo",
 				Type:        schema.TypeString,
@@ -315,6 +322,13 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
+			"password_credentials": {
+				Description: "The passwords (client secrets) associated with the application, excluding the secret values",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        schemaCredentialMetadata("password"),
+			},
+
 			"privacy_statement_url": {
 				Description: "URL of the application's privacy statement",
 				Type:        schema.TypeString,
@@ -379,6 +393,12 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
+			"saml_metadata_url": {
+				Description: "The URL where the service exposes SAML metadata for federation",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
 			"sign_in_audience": {
 				Description: "The Microsoft account types that are supported for the current application",
 				Type:        schema.TypeString,
@@ -423,6 +443,12 @@ func applicationDataSource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"token_encryption_key_id": {
+				Description: "The key ID of a certificate in the application's key credentials, with which Azure AD encrypts emitted tokens",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
 			"web": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -552,14 +578,15 @@ func applicationDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 	tf.Set(d, "app_role_ids", flattenApplicationAppRoleIDs(app.AppRoles))
 	tf.Set(d, "application_id", app.AppId)
 	tf.Set(d, "device_only_auth_enabled", app.IsDeviceOnlyAuthSupported)
-	tf.Set(d, "disabled_by_microsoft", fmt.Sprintf("%v", app.DisabledByMicrosoftStatus))
+	tf.Set(d, "disabled_by_microsoft", flattenApplicationDisabledByMicrosoft(app.DisabledByMicrosoftStatus))
 	tf.Set(d, "display_name", app.DisplayName)
 	tf.Set(d, "fallback_public_client_enabled", app.IsFallbackPublicClient)
 	tf.Set(d, "feature_tags", helpers.ApplicationFlattenFeatures(app.Tags, false))
-	tf.Set(d, "group_membership_claims", tf.FlattenStringSlicePtr(app.GroupMembershipClaims))
+	tf.Set(d, "group_membership_claims", flattenApplicationGroupMembershipClaims(app.GroupMembershipClaims))
 	tf.Set(d, "identifier_uris", tf.FlattenStringSlicePtr(app.IdentifierUris))
 	tf.Set(d, "oauth2_post_response_required", app.Oauth2RequirePostResponse)
 	tf.Set(d, "object_id", app.ID)
+	tf.Set(d, "key_credentials", flattenApplicationKeyCredentialsMetadata(app.KeyCredentials))
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaims(app.OptionalClaims))
 	tf.Set(d, "public_client", flattenApplicationPublicClient(app.PublicClient))
 	tf.Set(d, "publisher_domain", app.PublisherDomain)
@@ -567,6 +594,7 @@ func applicationDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 	tf.Set(d, "sign_in_audience", app.SignInAudience)
 	tf.Set(d, "single_page_application", flattenApplicationSpa(app.Spa))
 	tf.Set(d, "tags", app.Tags)
+	tf.Set(d, "token_encryption_key_id", app.TokenEncryptionKeyId)
 	tf.Set(d, "web", flattenApplicationWeb(app.Web))
 
 	if app.Api != nil {
@@ -581,11 +609,24 @@ func applicationDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 		tf.Set(d, "terms_of_service_url", app.Info.TermsOfServiceUrl)
 	}
 
+	samlSettings, _, err := meta.(*clients.Client).Applications.ApplicationSamlSettingsClient.Get(ctx, *app.ID)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve SAML settings for application with object ID %q", *app.ID)
+	}
+	samlMetadataUrl := ""
+	if samlSettings.SamlMetadataUrl != nil {
+		samlMetadataUrl = string(*samlSettings.SamlMetadataUrl)
+	}
+	tf.Set(d, "saml_metadata_url", samlMetadataUrl)
+
 	owners, _, err := client.ListOwners(ctx, *app.ID)
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for application with object ID %q", *app.ID)
 	}
 	tf.Set(d, "owners", owners)
 
+	passwordCredentials := flattenApplicationPasswordCredentialsMetadata(app.PasswordCredentials)
+	tf.Set(d, "password_credentials", passwordCredentials)
+
 	return nil
 }
@@ -55,6 +55,7 @@ func (ApplicationDataSource) testCheck(data acceptance.TestData) resource.TestCh
 		check.That(data.ResourceName).Key("api.0.oauth2_permission_scopes.#").HasValue("2"),
 		check.That(data.ResourceName).Key("app_roles.#").HasValue("2"),
 		check.That(data.ResourceName).Key("app_role_ids.%").HasValue("2"),
+		check.That(data.ResourceName).Key("disabled_by_microsoft").IsEmpty(),
 		check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-APP-complete-%d", data.RandomInteger)),
 		check.That(data.ResourceName).Key("feature_tags.#").HasValue("1"),
 		check.That(data.ResourceName).Key("feature_tags.0.custom_single_sign_on").HasValue("true"),
@@ -64,10 +65,12 @@ func (ApplicationDataSource) testCheck(data acceptance.TestData) resource.TestCh
 		check.That(data.ResourceName).Key("group_membership_claims.#").HasValue("1"),
 		check.That(data.ResourceName).Key("group_membership_claims.0").HasValue("All"),
 		check.That(data.ResourceName).Key("identifier_uris.#").HasValue("2"),
+		check.That(data.ResourceName).Key("key_credentials.#").HasValue("0"),
 		check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
 		check.That(data.ResourceName).Key("optional_claims.#").HasValue("1"),
 		check.That(data.ResourceName).Key("optional_claims.0.access_token.#").HasValue("2"),
 		check.That(data.ResourceName).Key("optional_claims.0.id_token.#").HasValue("1"),
+		check.That(data.ResourceName).Key("password_credentials.#").HasValue("0"),
 		check.That(data.ResourceName).Key("required_resource_access.#").HasValue("2"),
 		check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADandPersonalMicrosoftAccount"),
 		check.That(data.ResourceName).Key("tags.#").HasValue("4"),
@@ -0,0 +1,263 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	applicationsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+// The API returns the fully-qualified name of an extension property, which is prefixed with the application ID of the
+// owning application (without hyphens)
+var applicationExtensionNamePrefix = regexp.MustCompile("^extension_[0-9a-fA-F]{32}_")
+
+func applicationExtensionResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationExtensionResourceCreate,
+		ReadContext:   applicationExtensionResourceRead,
+		DeleteContext: applicationExtensionResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ExtensionPropertyID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Description:      "The object ID of the application for which this extension should be registered",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"name": {
+				Description: "The name of the extension, which will be prefixed with the application ID of the owning application",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateDiagFunc: validate.ValidateDiag(validation.All(
+					validation.StringLenBetween(1, 80),
+					validation.StringMatch(regexp.MustCompile("^[A-Za-z0-9_]+$"), "must contain only alphanumeric characters and underscores"),
+				)),
+			},
+
+			"data_type": {
+				Description: "The data type of the value the extension property can hold",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					applicationsClient.ExtensionPropertyDataTypeBinary,
+					applicationsClient.ExtensionPropertyDataTypeBoolean,
+					applicationsClient.ExtensionPropertyDataTypeDateTime,
+					applicationsClient.ExtensionPropertyDataTypeInteger,
+					applicationsClient.ExtensionPropertyDataTypeLargeInteger,
+					applicationsClient.ExtensionPropertyDataTypeString,
+				}, false),
+			},
+
+			"target_objects": {
+				Description: "The directory objects that support the extension property",
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						applicationsClient.ExtensionPropertyTargetObjectAdministrativeUnit,
+						applicationsClient.ExtensionPropertyTargetObjectApplication,
+						applicationsClient.ExtensionPropertyTargetObjectDevice,
+						applicationsClient.ExtensionPropertyTargetObjectGroup,
+						applicationsClient.ExtensionPropertyTargetObjectOrganization,
+						applicationsClient.ExtensionPropertyTargetObjectUser,
+					}, false),
+				},
+			},
+
+			"extension_id": {
+				Description: "The object ID of the extension property",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"extension_name": {
+				Description: "The fully-qualified name of the extension, including the application ID prefix, which is used when reading or writing the extension property on a directory object",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func applicationExtensionResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	extensionPropertiesClient := meta.(*clients.Client).Applications.ExtensionPropertiesClient
+	objectId := d.Get("application_object_id").(string)
+
+	tf.LockByName(applicationResourceName, objectId)
+	defer tf.UnlockByName(applicationResourceName, objectId)
+
+	app, status, err := client.Get(ctx, objectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", objectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", objectId)
+	}
+
+	properties := applicationsClient.ExtensionProperty{
+		DataType:      utils.String(d.Get("data_type").(string)),
+		Name:          utils.String(d.Get("name").(string)),
+		TargetObjects: tf.ExpandStringSlicePtr(d.Get("target_objects").(*schema.Set).List()),
+	}
+
+	extensionProperty, _, err := extensionPropertiesClient.Create(ctx, *app.ID, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Adding extension %q for application with object ID %q", d.Get("name").(string), *app.ID)
+	}
+	if extensionProperty == nil || extensionProperty.ID == nil || *extensionProperty.ID == "" {
+		return tf.ErrorDiagF(errors.New("nil extension property or extension property with nil ID was returned"), "API error adding extension for application with object ID %q", *app.ID)
+	}
+
+	id := parse.NewExtensionPropertyID(*app.ID, *extensionProperty.ID)
+
+	// Wait for the extension property to replicate
+	timeout, _ := ctx.Deadline()
+	polledForExtension, err := (&resource.StateChangeConf{
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   time.Until(timeout),
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Refresh: func() (interface{}, string, error) {
+			extensionProperties, _, err := extensionPropertiesClient.List(ctx, id.ObjectId)
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			if extensionProperties != nil {
+				for _, ext := range *extensionProperties {
+					if ext.ID != nil && strings.EqualFold(*ext.ID, id.ExtensionPropertyId) {
+						return &ext, "Done", nil
+					}
+				}
+			}
+
+			return nil, "Waiting", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for extension for application with object ID %q", id.ObjectId)
+	} else if polledForExtension == nil {
+		return tf.ErrorDiagF(errors.New("extension property not found for application"), "Waiting for extension for application with object ID %q", id.ObjectId)
+	}
+
+	d.SetId(id.String())
+
+	return applicationExtensionResourceRead(ctx, d, meta)
+}
+
+func applicationExtensionResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	extensionPropertiesClient := meta.(*clients.Client).Applications.ExtensionPropertiesClient
+
+	id, err := parse.ExtensionPropertyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing extension with ID %q", d.Id())
+	}
+
+	extensionProperty, status, err := extensionPropertiesClient.Get(ctx, id.ObjectId, id.ExtensionPropertyId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Extension with ID %q for Application %s was not found - removing from state!", id.ExtensionPropertyId, id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving extension with ID %q for application with object ID %q", id.ExtensionPropertyId, id.ObjectId)
+	}
+
+	name := ""
+	if extensionProperty.Name != nil {
+		name = applicationExtensionNamePrefix.ReplaceAllString(*extensionProperty.Name, "")
+	}
+
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "data_type", extensionProperty.DataType)
+	tf.Set(d, "extension_id", id.ExtensionPropertyId)
+	tf.Set(d, "extension_name", extensionProperty.Name)
+	tf.Set(d, "name", name)
+	tf.Set(d, "target_objects", tf.FlattenStringSlicePtr(extensionProperty.TargetObjects))
+
+	return nil
+}
+
+func applicationExtensionResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	extensionPropertiesClient := meta.(*clients.Client).Applications.ExtensionPropertiesClient
+
+	id, err := parse.ExtensionPropertyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing extension with ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	if status, err := extensionPropertiesClient.Delete(ctx, id.ObjectId, id.ExtensionPropertyId); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing extension %q from application with object ID %q", id.ExtensionPropertyId, id.ObjectId)
+	}
+
+	// Wait for the extension property to be deleted
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		extensionPropertiesClient.BaseClient.DisableRetries = true
+
+		extensionProperties, _, err := extensionPropertiesClient.List(ctx, id.ObjectId)
+		if err != nil {
+			return nil, err
+		}
+
+		if extensionProperties != nil {
+			for _, ext := range *extensionProperties {
+				if ext.ID != nil && strings.EqualFold(*ext.ID, id.ExtensionPropertyId) {
+					return utils.Bool(true), nil
+				}
+			}
+		}
+
+		return utils.Bool(false), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of extension %q from application with object ID %q", id.ExtensionPropertyId, id.ObjectId)
+	}
+
+	return nil
+}
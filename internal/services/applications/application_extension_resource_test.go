@@ -0,0 +1,123 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationExtensionResource struct{}
+
+func TestAccApplicationExtension_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_extension", "test")
+	r := ApplicationExtensionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_id").IsUuid(),
+				check.That(data.ResourceName).Key("extension_name").MatchesRegex(regexp.MustCompile(fmt.Sprintf("^extension_[0-9a-f]{32}_acctest%s$", data.RandomString))),
+				check.That(data.ResourceName).Key("target_objects.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationExtension_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_extension", "test")
+	r := ApplicationExtensionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multiple(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_application_extension.test2").ExistsInAzure(r),
+				check.That("azuread_application_extension.test2").Key("target_objects.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationExtensionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ExtensionPropertiesClient
+	client.BaseClient.DisableRetries = true
+
+	id, err := parse.ExtensionPropertyID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Application Extension ID: %v", err)
+	}
+
+	extensionProperty, status, err := client.Get(ctx, id.ObjectId, id.ExtensionPropertyId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Extension %q for Application with object ID %q does not exist", id.ExtensionPropertyId, id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Extension %q for Application with object ID %q: %+v", id.ExtensionPropertyId, id.ObjectId, err)
+	}
+
+	return utils.Bool(extensionProperty != nil), nil
+}
+
+func (ApplicationExtensionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestApplicationExtension-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationExtensionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_extension" "test" {
+  application_object_id = azuread_application.test.object_id
+  name                  = "acctest%[2]s"
+  data_type             = "String"
+  target_objects        = ["User"]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationExtensionResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_extension" "test2" {
+  application_object_id = azuread_application.test.object_id
+  name                  = "acctest%[2]s2"
+  data_type             = "Boolean"
+  target_objects        = ["Group", "User"]
+}
+`, r.basic(data), data.RandomString)
+}
@@ -0,0 +1,189 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func applicationIdentifierUriResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationIdentifierUriResourceCreate,
+		ReadContext:   applicationIdentifierUriResourceRead,
+		DeleteContext: applicationIdentifierUriResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ApplicationIdentifierUriID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Description:      "The object ID of the application to which this identifier URI should be added",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"identifier_uri": {
+				Description:      "The user-defined URI that uniquely identifies the application within its Azure AD tenant, or within a verified custom domain if the application is multi-tenant",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.IsAppUri,
+			},
+		},
+	}
+}
+
+func applicationIdentifierUriResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	id := parse.NewApplicationIdentifierUriID(d.Get("application_object_id").(string), d.Get("identifier_uri").(string))
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", id.ObjectId)
+	}
+
+	newIdentifierUris := make([]string, 0)
+	if app.IdentifierUris != nil {
+		for _, uri := range *app.IdentifierUris {
+			if strings.EqualFold(uri, id.IdentifierUri) {
+				return tf.ImportAsExistsDiag("azuread_application_identifier_uri", id.String())
+			}
+			newIdentifierUris = append(newIdentifierUris, uri)
+		}
+	}
+
+	newIdentifierUris = append(newIdentifierUris, id.IdentifierUri)
+
+	properties := msgraph.Application{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: app.ID,
+		},
+		IdentifierUris: &newIdentifierUris,
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Adding identifier URI %q for application with object ID %q", id.IdentifierUri, id.ObjectId)
+	}
+
+	d.SetId(id.String())
+
+	return applicationIdentifierUriResourceRead(ctx, d, meta)
+}
+
+func applicationIdentifierUriResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	id, err := parse.ApplicationIdentifierUriID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing identifier URI ID %q", d.Id())
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with ID %q for identifier URI %q was not found - removing from state!", id.ObjectId, id.IdentifierUri)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", id.ObjectId)
+	}
+
+	var identifierUri string
+	if app.IdentifierUris != nil {
+		for _, uri := range *app.IdentifierUris {
+			if strings.EqualFold(uri, id.IdentifierUri) {
+				identifierUri = uri
+				break
+			}
+		}
+	}
+	if identifierUri == "" {
+		log.Printf("[DEBUG] No matching identifier URI for ID %q - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "identifier_uri", identifierUri)
+
+	return nil
+}
+
+func applicationIdentifierUriResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	id, err := parse.ApplicationIdentifierUriID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing identifier URI ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with ID %q for identifier URI %q was not found - removing from state!", id.ObjectId, id.IdentifierUri)
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
+	}
+	if app == nil || app.ID == nil {
+		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", id.ObjectId)
+	}
+
+	newIdentifierUris := make([]string, 0)
+	if app.IdentifierUris != nil {
+		for _, uri := range *app.IdentifierUris {
+			if !strings.EqualFold(uri, id.IdentifierUri) {
+				newIdentifierUris = append(newIdentifierUris, uri)
+			}
+		}
+	}
+
+	properties := msgraph.Application{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: app.ID,
+		},
+		IdentifierUris: &newIdentifierUris,
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Removing identifier URI %q from application with object ID %q", id.IdentifierUri, id.ObjectId)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationIdentifierUriResource struct{}
+
+func TestAccApplicationIdentifierUri_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_identifier_uri", "test")
+	r := ApplicationIdentifierUriResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identifier_uri").HasValue(fmt.Sprintf("api://acctestApp-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationIdentifierUri_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_identifier_uri", "test")
+	r := ApplicationIdentifierUriResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_application_identifier_uri.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationIdentifierUri_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_identifier_uri", "test")
+	r := ApplicationIdentifierUriResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (ApplicationIdentifierUriResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationsClient
+	client.BaseClient.DisableRetries = true
+
+	id, err := parse.ApplicationIdentifierUriID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Identifier URI ID: %v", err)
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Application with object ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	if app.IdentifierUris != nil {
+		for _, uri := range *app.IdentifierUris {
+			if strings.EqualFold(uri, id.IdentifierUri) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Identifier URI %q was not found for Application %q", id.IdentifierUri, id.ObjectId)
+}
+
+func (ApplicationIdentifierUriResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestApp-%[1]d"
+
+  lifecycle {
+    ignore_changes = [identifier_uris]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationIdentifierUriResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_identifier_uri" "test" {
+  application_object_id = azuread_application.test.object_id
+  identifier_uri        = "api://acctestApp-%[2]d"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationIdentifierUriResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_identifier_uri" "second" {
+  application_object_id = azuread_application.test.object_id
+  identifier_uri        = "api://acctestApp-%[2]d-second"
+}
+`, r.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationIdentifierUriResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_identifier_uri" "import" {
+  application_object_id = azuread_application_identifier_uri.test.application_object_id
+  identifier_uri        = azuread_application_identifier_uri.test.identifier_uri
+}
+`, r.basic(data))
+}
@@ -0,0 +1,102 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func applicationPermissionIdsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: applicationPermissionIdsDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_app_id": {
+				Description:      "The application ID (client ID) of the resource application publishing the permissions. Defaults to the application ID of Microsoft Graph",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          environments.PublishedApis["MicrosoftGraph"],
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"resource_object_id": {
+				Description: "The object ID of the service principal for the resource application",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"app_role_ids": {
+				Description: "A mapping of app role values to app role IDs, for use with `Role` permissions in `required_resource_access`",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"oauth2_permission_scope_ids": {
+				Description: "A mapping of OAuth2.0 permission scope values to permission scope IDs, for use with `Scope` permissions in `required_resource_access`",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func applicationPermissionIdsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ServicePrincipalsClient
+	client.BaseClient.DisableRetries = true
+
+	resourceAppId := d.Get("resource_app_id").(string)
+
+	query := odata.Query{Filter: fmt.Sprintf("appId eq '%s'", resourceAppId)}
+	result, _, err := client.List(ctx, query)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing service principals with filter %q", query.Filter)
+	}
+	if result == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+	}
+
+	var servicePrincipal *msgraph.ServicePrincipal
+	for _, sp := range *result {
+		if sp.AppId != nil && strings.EqualFold(*sp.AppId, resourceAppId) {
+			servicePrincipal = &sp
+			break
+		}
+	}
+	if servicePrincipal == nil {
+		return tf.ErrorDiagPathF(nil, "resource_app_id", "No service principal was found in this tenant for the resource application with application ID %q", resourceAppId)
+	}
+	if servicePrincipal.ID == nil {
+		return tf.ErrorDiagF(errors.New("API returned service principal with nil object ID"), "Bad API Response")
+	}
+
+	d.SetId(fmt.Sprintf("permissionIds#%s", *servicePrincipal.ID))
+
+	tf.Set(d, "app_role_ids", helpers.ApplicationFlattenAppRoleIDs(servicePrincipal.AppRoles))
+	tf.Set(d, "oauth2_permission_scope_ids", helpers.ApplicationFlattenOAuth2PermissionScopeIDs(servicePrincipal.PublishedPermissionScopes))
+	tf.Set(d, "resource_object_id", servicePrincipal.ID)
+
+	return nil
+}
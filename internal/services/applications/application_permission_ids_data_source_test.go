@@ -0,0 +1,79 @@
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationPermissionIdsDataSource struct{}
+
+func TestAccApplicationPermissionIdsDataSource_microsoftGraph(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_permission_ids", "test")
+	r := ApplicationPermissionIdsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.microsoftGraph(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_app_id").HasValue("00000003-0000-0000-c000-000000000000"),
+				check.That(data.ResourceName).Key("resource_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("app_role_ids.User.Read.All").HasValue("df021288-bdef-4463-88db-98f22de89214"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.User.Read").HasValue("e1fe6dd8-ba31-4d61-89e7-88639da4683d"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationPermissionIdsDataSource_requiredResourceAccess(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationPermissionIdsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.requiredResourceAccess(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("required_resource_access.0.resource_access.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (ApplicationPermissionIdsDataSource) microsoftGraph(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+data "azuread_application_permission_ids" "test" {}
+`
+}
+
+func (ApplicationPermissionIdsDataSource) requiredResourceAccess(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_application_permission_ids" "msgraph" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_permission_ids.msgraph.resource_app_id
+
+    resource_access {
+      id   = data.azuread_application_permission_ids.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = data.azuread_application_permission_ids.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+  }
+}
+`, data.RandomInteger)
+}
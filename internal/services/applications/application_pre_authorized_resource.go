@@ -224,6 +224,9 @@ func applicationPreAuthorizedResourceDelete(ctx context.Context, d *schema.Resou
 		return tf.ErrorDiagPathF(err, "id", "Parsing pre-authorized application ID %q", d.Id())
 	}
 
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
 	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
 	if err != nil {
 		if status == http.StatusNotFound {
@@ -244,7 +247,6 @@ func applicationPreAuthorizedResourceDelete(ctx context.Context, d *schema.Resou
 	for _, a := range *app.Api.PreAuthorizedApplications {
 		if a.AppId != nil && !strings.EqualFold(*a.AppId, id.AppId) {
 			newPreAuthorizedApps = append(newPreAuthorizedApps, a)
-			break
 		}
 	}
 
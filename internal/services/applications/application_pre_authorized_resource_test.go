@@ -52,6 +52,31 @@ func TestAccApplicationPreAuthorized_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccApplicationPreAuthorized_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_pre_authorized", "test")
+	r := ApplicationPreAuthorizedResource{}
+	scopeId := data.UUID()
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multiple(data, scopeId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_application_pre_authorized.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.single(data, scopeId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ApplicationPreAuthorizedResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Applications.ApplicationsClient
 	client.BaseClient.DisableRetries = true
@@ -131,3 +156,62 @@ resource "azuread_application_pre_authorized" "import" {
 }
 `, r.basic(data), data.UUID())
 }
+
+func (ApplicationPreAuthorizedResource) template(data acceptance.TestData, scopeId string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "first" {
+  display_name = "acctestApp-authorized-first-%[1]d"
+}
+
+resource "azuread_application" "second" {
+  display_name = "acctestApp-authorized-second-%[1]d"
+}
+
+resource "azuread_application" "authorizer" {
+  display_name = "acctestApp-authorizer-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Access the application"
+      admin_consent_display_name = "Access"
+      enabled                    = true
+      id                         = "%[2]s"
+      type                       = "User"
+      user_consent_description   = "Access the application"
+      user_consent_display_name  = "Access"
+      value                      = "user_impersonation"
+    }
+  }
+}
+`, data.RandomInteger, scopeId)
+}
+
+func (r ApplicationPreAuthorizedResource) multiple(data acceptance.TestData, scopeId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_pre_authorized" "test" {
+  application_object_id = azuread_application.authorizer.object_id
+  authorized_app_id     = azuread_application.first.application_id
+  permission_ids        = ["%[2]s"]
+}
+
+resource "azuread_application_pre_authorized" "second" {
+  application_object_id = azuread_application.authorizer.object_id
+  authorized_app_id     = azuread_application.second.application_id
+  permission_ids        = ["%[2]s"]
+}
+`, r.template(data, scopeId), scopeId)
+}
+
+func (r ApplicationPreAuthorizedResource) single(data acceptance.TestData, scopeId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_pre_authorized" "test" {
+  application_object_id = azuread_application.authorizer.object_id
+  authorized_app_id     = azuread_application.first.application_id
+  permission_ids        = ["%[2]s"]
+}
+`, r.template(data, scopeId), scopeId)
+}
@@ -45,12 +45,12 @@ func applicationResource() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+		Importer: tf.ValidateResourceIDPriorToImportThen(func(id string) error {
 			if _, err := uuid.ParseUUID(id); err != nil {
 				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
 			}
 			return nil
-		}),
+		}, applicationResourceImport),
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -96,12 +96,14 @@ func applicationResource() *schema.Resource {
 							Description: "One or more `oauth2_permission_scope` blocks to describe delegated permissions exposed by the web API represented by this application",
 							Type:        schema.TypeSet,
 							Optional:    true,
+							Set:         applicationOAuth2PermissionScopeHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"id": {
-										Description:      "The unique identifier of the delegated permission",
+										Description:      "The unique identifier of the delegated permission. When omitted, a stable ID is derived from the `value`",
 										Type:             schema.TypeString,
-										Required:         true,
+										Optional:         true,
+										Computed:         true,
 										ValidateDiagFunc: validate.UUID,
 									},
 
@@ -374,6 +376,13 @@ func applicationResource() *schema.Resource {
 				},
 			},
 
+			"ignore_unmanaged_owners": {
+				Description: "If `true`, owners of the application that are not specified in the `owners` property will be left intact, rather than being removed",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"owners": {
 				Description: "A list of object IDs of principals that will be granted ownership of the application",
 				Type:        schema.TypeSet,
@@ -456,6 +465,14 @@ func applicationResource() *schema.Resource {
 				},
 			},
 
+			"saml_metadata_url": {
+				Description:      "The URL where the service exposes SAML metadata for federation. Only valid for single-tenant applications",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.IsHttpOrHttpsUrl,
+			},
+
 			"sign_in_audience": {
 				Description: "The Microsoft account types that are supported for the current application",
 				Type:        schema.TypeString,
@@ -517,12 +534,26 @@ func applicationResource() *schema.Resource {
 				ValidateDiagFunc: validate.UUID,
 			},
 
+			"template_service_principal_object_id": {
+				Description: "The object ID of the service principal that was created alongside the application, when the application is instantiated from a template",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
 			"terms_of_service_url": {
 				Description: "URL of the application's terms of service statement",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
 
+			"token_encryption_key_id": {
+				Description:      "The key ID of a certificate in the application's key credentials, with which Azure AD will encrypt emitted tokens",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
 			"web": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -534,14 +565,14 @@ func applicationResource() *schema.Resource {
 							Description:      "Home page or landing page of the application",
 							Type:             schema.TypeString,
 							Optional:         true,
-							ValidateDiagFunc: validate.IsHttpOrHttpsUrl,
+							ValidateDiagFunc: validate.EmptyStringOr(validate.IsHttpOrHttpsUrl),
 						},
 
 						"logout_url": {
 							Description:      "The URL that will be used by Microsoft's authorization service to sign out a user using front-channel, back-channel or SAML logout protocols",
 							Type:             schema.TypeString,
 							Optional:         true,
-							ValidateDiagFunc: validate.IsLogoutUrl,
+							ValidateDiagFunc: validate.EmptyStringOr(validate.IsLogoutUrl),
 						},
 
 						"redirect_uris": {
@@ -598,6 +629,22 @@ func applicationResource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"disable_on_destroy": {
+				Description:   "If `true`, the application will not be deleted when it is destroyed. Instead, its identifier URIs will be removed and sign-in will be disabled for its service principal, and it will be left in place",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"permanently_delete"},
+			},
+
+			"permanently_delete": {
+				Description:   "If `true`, the application will be permanently deleted from the deleted items when it is destroyed, instead of being soft-deleted",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"disable_on_destroy"},
+			},
+
 			"prevent_duplicate_names": {
 				Description: "If `true`, will return an error if an existing application is found with the same name",
 				Type:        schema.TypeBool,
@@ -605,10 +652,26 @@ func applicationResource() *schema.Resource {
 				Default:     false,
 			},
 
+			"restore_if_deleted": {
+				Description: "If `true`, a soft-deleted application having the same identifier URIs (or if none are specified, the same display name) will be restored instead of creating a new application",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"validate_required_resource_access": {
+				Description: "If `true`, the roles and scopes specified in `required_resource_access` will be checked against the service principal of each resource application, and a warning will be emitted for any that cannot be found",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"publisher_domain": {
-				Description: "The verified publisher domain for the application",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:      "The verified publisher domain for the application",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
 			"disabled_by_microsoft": {
@@ -616,6 +679,55 @@ func applicationResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+
+			"key_credentials": {
+				Description: "The certificates associated with the application, excluding any key material",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        schemaCredentialMetadata("certificate"),
+			},
+
+			"password_credentials": {
+				Description: "The passwords (client secrets) associated with the application, excluding the secret values",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        schemaCredentialMetadata("password"),
+			},
+
+			"verified_publisher_id": {
+				Description:      "The Microsoft Partner Network (MPN) ID of the verified publisher to set for the application",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"verified_publisher": {
+				Description: "The verified publisher for the application",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"added_date_time": {
+							Description: "The timestamp when the verified publisher was first added or most recently updated",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The verified publisher name from the app publisher's Partner Center account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"verified_publisher_id": {
+							Description: "The Microsoft Partner Network (MPN) ID of the verified publisher",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -642,11 +754,83 @@ func applicationResourceCustomizeDiff(ctx context.Context, diff *schema.Resource
 		}
 	}
 
+	// The SAML metadata URL is only valid for single-tenant applications
+	if diff.Get("saml_metadata_url").(string) != "" && diff.Get("sign_in_audience").(string) != msgraph.SignInAudienceAzureADMyOrg {
+		return fmt.Errorf("`saml_metadata_url` can only be specified when `sign_in_audience` is %q", msgraph.SignInAudienceAzureADMyOrg)
+	}
+
+	// `None` cannot be combined with any other group membership claims
+	if claims := diff.Get("group_membership_claims").(*schema.Set).List(); len(claims) > 1 {
+		for _, claim := range claims {
+			if claim.(string) == msgraph.GroupMembershipClaimNone {
+				return fmt.Errorf("`group_membership_claims` cannot contain %q together with any other value", msgraph.GroupMembershipClaimNone)
+			}
+		}
+	}
+
+	// Group-specific additional properties of optional claims only have an effect on the `groups` claim
+	if diff.NewValueKnown("optional_claims") {
+		if err := applicationValidateOptionalClaims(diff.Get("optional_claims").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	// Validate roles and scopes to check for duplicate IDs or values
 	if err := applicationValidateRolesScopes(diff.Get("app_role").(*schema.Set).List(), diff.Get("api.0.oauth2_permission_scope").(*schema.Set).List()); err != nil {
 		return fmt.Errorf("checking for duplicate app roles / OAuth2.0 permission scopes: %v", err)
 	}
 
+	// Admin scopes cannot be consented to by users, whereas user scopes must describe themselves to consenting users.
+	// Values may not be known when they are interpolated from other resources, in which case the API will validate them.
+	if diff.NewValueKnown("api.0.oauth2_permission_scope") {
+		if err := applicationValidateOAuth2PermissionScopeConsent(diff.Get("api.0.oauth2_permission_scope").(*schema.Set).List()); err != nil {
+			return err
+		}
+		if err := applicationValidateOAuth2PermissionScopeIds(diff.Get("api.0.oauth2_permission_scope").(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	// Ensure that existing roles and scopes are not assigned new IDs
+	if diff.Id() != "" {
+		oldRoles, newRoles := diff.GetChange("app_role")
+		if err := applicationValidateStableIds(oldRoles.(*schema.Set).List(), newRoles.(*schema.Set).List(), "app_role"); err != nil {
+			return err
+		}
+
+		oldScopes, newScopes := diff.GetChange("api.0.oauth2_permission_scope")
+		if err := applicationValidateStableIds(oldScopes.(*schema.Set).List(), newScopes.(*schema.Set).List(), "oauth2_permission_scope"); err != nil {
+			return err
+		}
+
+		// Removing a role or scope which is still enabled can break consumers which have been granted it
+		preventRemoval := meta.(*clients.Client).PreventEnabledAppRoleAndScopeRemoval
+		for _, v := range []struct {
+			blockName string
+			removed   []string
+		}{
+			{"app_role", applicationRemovedEnabledRolesScopes(oldRoles.(*schema.Set).List(), newRoles.(*schema.Set).List())},
+			{"oauth2_permission_scope", applicationRemovedEnabledRolesScopes(oldScopes.(*schema.Set).List(), newScopes.(*schema.Set).List())},
+		} {
+			if len(v.removed) == 0 {
+				continue
+			}
+			if preventRemoval {
+				return fmt.Errorf("the following enabled `%s` blocks are being removed: %s. Set `enabled = false` and apply before removing them, or disable the provider setting `prevent_enabled_app_role_and_scope_removal`", v.blockName, strings.Join(v.removed, ", "))
+			}
+			// Warnings cannot be returned from CustomizeDiff, so a warning diagnostic is instead emitted when the change is applied
+			log.Printf("[WARN] Enabled %s blocks are being removed from application %q: %s", v.blockName, diff.Id(), strings.Join(v.removed, ", "))
+		}
+	}
+
+	// A maximum of 256 redirect URIs are supported per application, across all platforms
+	redirectUriCount := len(diff.Get("public_client.0.redirect_uris").(*schema.Set).List()) +
+		len(diff.Get("single_page_application.0.redirect_uris").(*schema.Set).List()) +
+		len(diff.Get("web.0.redirect_uris").(*schema.Set).List())
+	if redirectUriCount > 256 {
+		return fmt.Errorf("a maximum of 256 `redirect_uris` are supported across the `public_client`, `single_page_application` and `web` blocks, but %d were specified", redirectUriCount)
+	}
+
 	// If app roles or permission scopes have changed, the corresponding maps indexed by value will also change
 	if diff.HasChange("app_role") {
 		diff.SetNewComputed("app_role_ids")
@@ -655,6 +839,11 @@ func applicationResourceCustomizeDiff(ctx context.Context, diff *schema.Resource
 		diff.SetNewComputed("oauth2_permission_scope_ids")
 	}
 
+	// Changing the feature tags of an existing application also changes the full list of tags
+	if diff.Id() != "" && diff.HasChange("feature_tags") && !diff.HasChange("tags") {
+		diff.SetNewComputed("tags")
+	}
+
 	// If the logo image changes, the CDN URL will change
 	if diff.HasChange("logo_image") {
 		diff.SetNewComputed("logo_url")
@@ -842,33 +1031,25 @@ func applicationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 		if len(webRaw) == 1 {
 			suppress = true
 			web := webRaw[0].(map[string]interface{})
+			for _, urlKey := range []string{"homepage_url", "logout_url"} {
+				if v, ok := web[urlKey]; ok && v.(string) != "" {
+					suppress = false
+				}
+			}
 			if v, ok := web["redirect_uris"]; ok && len(v.(*schema.Set).List()) > 0 {
 				suppress = false
 			}
-			if b, ok := web["implicit_grant"]; ok {
-				if implicitGrantRaw := b.([]interface{}); len(implicitGrantRaw) > 0 {
-					implicitGrant := implicitGrantRaw[0].(map[string]interface{})
-					if v, ok := implicitGrant["access_token_issuance_enabled"]; ok && v.(bool) {
-						suppress = false
-					}
-					if v, ok := implicitGrant["id_token_issuance_enabled"]; ok && v.(bool) {
-						suppress = false
-					}
-				}
+			if v, ok := web["implicit_grant"]; ok && applicationImplicitGrantEnabled(v.([]interface{})) {
+				suppress = false
 			}
 		}
 
 	case k == "web.0.implicit_grant.#" && old == "1" && new == "0":
+		// An absent implicit_grant block is equivalent to one with both flags disabled, so only show a diff when
+		// removing the block would disable a token issuance flag which is currently enabled
 		implicitGrantRaw := d.Get("web.0.implicit_grant").([]interface{})
 		if len(implicitGrantRaw) == 1 {
-			suppress = true
-			implicitGrant := implicitGrantRaw[0].(map[string]interface{})
-			if v, ok := implicitGrant["access_token_issuance_enabled"]; ok && v.(bool) {
-				suppress = false
-			}
-			if v, ok := implicitGrant["id_token_issuance_enabled"]; ok && v.(bool) {
-				suppress = false
-			}
+			suppress = !applicationImplicitGrantEnabled(implicitGrantRaw)
 		}
 	}
 
@@ -879,6 +1060,10 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 	client := meta.(*clients.Client).Applications.ApplicationsClient
 	appTemplatesClient := meta.(*clients.Client).Applications.ApplicationTemplatesClient
 	directoryObjectsClient := meta.(*clients.Client).Applications.DirectoryObjectsClient
+	domainsClient := meta.(*clients.Client).Applications.DomainsClient
+	if err := meta.(*clients.Client).LoadClaims(); err != nil {
+		return tf.ErrorDiagF(err, "Could not obtain claims for the authenticated principal")
+	}
 	callerId := meta.(*clients.Client).Claims.ObjectId
 	displayName := d.Get("display_name").(string)
 	templateId := d.Get("template_id").(string)
@@ -898,6 +1083,24 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.Get("restore_if_deleted").(bool) && templateId == "" {
+		identifierUris := tf.ExpandStringSlice(d.Get("identifier_uris").(*schema.Set).List())
+		deletedApp, err := applicationFindDeleted(ctx, client, displayName, identifierUris)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not check for deleted application(s)")
+		}
+		if deletedApp != nil {
+			if _, _, err := client.RestoreDeleted(ctx, *deletedApp.ID); err != nil {
+				return tf.ErrorDiagF(err, "Could not restore deleted application with object ID %q", *deletedApp.ID)
+			}
+
+			d.SetId(*deletedApp.ID)
+
+			// The application was restored with its previous configuration, so we'll update it just as if it was imported
+			return applicationResourceUpdate(ctx, d, meta)
+		}
+	}
+
 	var imageContentType string
 	var imageData []byte
 	if v, ok := d.GetOk("logo_image"); ok && v != "" {
@@ -915,6 +1118,13 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		tags = tf.ExpandStringSlice(d.Get("tags").(*schema.Set).List())
 	}
 
+	publisherDomain := d.Get("publisher_domain").(string)
+	if publisherDomain != "" {
+		if err := applicationValidatePublisherDomain(ctx, domainsClient, publisherDomain); err != nil {
+			return tf.ErrorDiagPathF(err, "publisher_domain", "Invalid publisher domain")
+		}
+	}
+
 	if templateId != "" {
 		// Instantiate application from template gallery and return via the update function
 		properties := msgraph.ApplicationTemplate{
@@ -927,17 +1137,25 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 			return tf.ErrorDiagF(err, "Could not instantiate application from template")
 		}
 
-		if result.Application == nil {
-			return tf.ErrorDiagF(errors.New("Bad API response"), "Nil application object returned for instantiated application")
+		// Instantiating a template creates both an application and a service principal, so if anything fails from here
+		// on, we should try to clean up both objects so that they are not orphaned
+		if result.Application == nil || result.Application.ID == nil || *result.Application.ID == "" {
+			return applicationTemplateCleanup(ctx, meta, result, tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for instantiated application is nil/empty"))
 		}
-		if result.Application.ID == nil || *result.Application.ID == "" {
-			return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for instantiated application is nil/empty")
+		if result.ServicePrincipal == nil || result.ServicePrincipal.ID == nil || *result.ServicePrincipal.ID == "" {
+			return applicationTemplateCleanup(ctx, meta, result, tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for instantiated service principal is nil/empty"))
 		}
 
 		d.SetId(*result.Application.ID)
 
 		// The application was created out of band, so we'll update it just as if it was imported
-		return applicationResourceUpdate(ctx, d, meta)
+		diags := applicationResourceUpdate(ctx, d, meta)
+		if diags.HasError() {
+			d.SetId("")
+			return applicationTemplateCleanup(ctx, meta, result, diags)
+		}
+
+		return diags
 	}
 
 	// Set a temporary display name as we'll attempt to patch the application with the correct name after creating it
@@ -972,6 +1190,10 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		Web:                       expandApplicationWeb(d.Get("web").([]interface{})),
 	}
 
+	if publisherDomain != "" {
+		properties.PublisherDomain = utils.String(publisherDomain)
+	}
+
 	// Sort the owners into two slices, the first containing up to 20 and the rest overflowing to the second slice
 	// The calling principal should always be in the first slice of owners
 	callerObject, _, err := directoryObjectsClient.Get(ctx, callerId, odata.Query{})
@@ -1024,7 +1246,7 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	app, _, err := client.Create(ctx, properties)
 	if err != nil {
-		return tf.ErrorDiagF(err, "Could not create application")
+		return tf.ODataErrorDiagF(err, "Could not create application")
 	}
 
 	if app.ID == nil || *app.ID == "" {
@@ -1045,7 +1267,7 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagF(err, "Timed out whilst waiting for new application to be replicated in Azure AD")
 		}
-		return tf.ErrorDiagF(err, "Failed to patch application after creating")
+		return tf.ODataErrorDiagF(err, "Failed to patch application after creating")
 	}
 
 	if len(ownersExtra) > 0 {
@@ -1063,6 +1285,11 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// Wait for the configured owners to be consistently reflected, since these are read back immediately
+	if err := applicationWaitForOwners(ctx, client, d.Id(), tf.ExpandStringSlice(d.Get("owners").(*schema.Set).List())); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for owners of application with object ID %q to be updated", d.Id())
+	}
+
 	// Upload the application image
 	if imageContentType != "" && len(imageData) > 0 {
 		_, err := client.UploadLogo(ctx, d.Id(), imageContentType, imageData)
@@ -1071,14 +1298,37 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	return applicationResourceRead(ctx, d, meta)
+	// Set the verified publisher, which requires a separate API call
+	if v, ok := d.GetOk("verified_publisher_id"); ok && v.(string) != "" {
+		if diags := applicationSetVerifiedPublisher(ctx, meta.(*clients.Client).Applications.VerifiedPublisherClient, d.Id(), v.(string)); diags.HasError() {
+			return diags
+		}
+	}
+
+	// Set the SAML settings, which are not supported by the SDK when creating the application
+	if d.Get("saml_metadata_url").(string) != "" || d.Get("token_encryption_key_id").(string) != "" {
+		if _, err := meta.(*clients.Client).Applications.ApplicationSamlSettingsClient.Update(ctx, d.Id(), expandApplicationSamlSettings(d)); err != nil {
+			return tf.ErrorDiagF(err, "Could not set SAML settings for application with object ID: %q", d.Id())
+		}
+	}
+
+	var diags diag.Diagnostics
+	if d.Get("validate_required_resource_access").(bool) {
+		diags = applicationValidateRequiredResourceAccess(ctx, meta.(*clients.Client).Applications.ServicePrincipalsClient, d.Get("required_resource_access").(*schema.Set).List())
+	}
+
+	return append(diags, applicationResourceRead(ctx, d, meta)...)
 }
 
 func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Applications.ApplicationsClient
+	domainsClient := meta.(*clients.Client).Applications.DomainsClient
 	applicationId := d.Id()
 	displayName := d.Get("display_name").(string)
 
+	tf.LockByName(applicationResourceName, applicationId)
+	defer tf.UnlockByName(applicationResourceName, applicationId)
+
 	// Perform this check at apply time to catch any duplicate names created during the same apply
 	if d.Get("prevent_duplicate_names").(bool) {
 		result, err := applicationFindByName(ctx, client, displayName)
@@ -1110,7 +1360,9 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	var tags []string
 	if v, ok := d.GetOk("feature_tags"); ok && len(v.([]interface{})) > 0 && d.HasChange("feature_tags") {
-		tags = helpers.ApplicationExpandFeatures(v.([]interface{}))
+		// Only the feature tags are managed here, so any other tags already present on the application are retained
+		existingTags := tf.ExpandStringSlice(d.Get("tags").(*schema.Set).List())
+		tags = helpers.ApplicationMergeFeatureTags(existingTags, helpers.ApplicationExpandFeatures(v.([]interface{})))
 	} else {
 		tags = tf.ExpandStringSlice(d.Get("tags").(*schema.Set).List())
 	}
@@ -1142,6 +1394,27 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		Web:                       expandApplicationWeb(d.Get("web").([]interface{})),
 	}
 
+	if v := d.Get("publisher_domain").(string); v != "" && d.HasChange("publisher_domain") {
+		if err := applicationValidatePublisherDomain(ctx, domainsClient, v); err != nil {
+			return tf.ErrorDiagPathF(err, "publisher_domain", "Invalid publisher domain for application with object ID %q", d.Id())
+		}
+		properties.PublisherDomain = utils.String(v)
+	}
+
+	// Changing the sign-in audience requires a specific sequence of updates, so this is done prior to updating the
+	// remaining properties
+	if d.HasChange("sign_in_audience") {
+		if diags := applicationMigrateSignInAudience(ctx, client, d); diags.HasError() {
+			return diags
+		}
+	}
+
+	// Note any enabled roles or scopes being removed, so that a warning can be emitted
+	oldRoles, newRoles := d.GetChange("app_role")
+	removedAppRoles := applicationRemovedEnabledRolesScopes(oldRoles.(*schema.Set).List(), newRoles.(*schema.Set).List())
+	oldScopes, newScopes := d.GetChange("api.0.oauth2_permission_scope")
+	removedScopes := applicationRemovedEnabledRolesScopes(oldScopes.(*schema.Set).List(), newScopes.(*schema.Set).List())
+
 	if err := applicationDisableAppRoles(ctx, client, &properties, expandApplicationAppRoles(d.Get("app_role").(*schema.Set).List())); err != nil {
 		return tf.ErrorDiagPathF(err, "app_role", "Could not disable App Roles for application with object ID %q", d.Id())
 	}
@@ -1150,8 +1423,8 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagPathF(err, "api.0.oauth2_permission_scope", "Could not disable OAuth2 Permission Scopes for application with object ID %q", d.Id())
 	}
 
-	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Could not update application with object ID: %q", d.Id())
+	if err := applicationUpdateAfterDisabling(ctx, client, properties); err != nil {
+		return tf.ODataErrorDiagF(err, "Could not update application with object ID: %q", d.Id())
 	}
 
 	if v, ok := d.GetOk("owners"); ok && d.HasChange("owners") {
@@ -1165,6 +1438,14 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
 		ownersToAdd := utils.Difference(desiredOwners, existingOwners)
 
+		// When ignoring unmanaged owners, only remove owners that were previously specified in configuration
+		ignoreUnmanagedOwners := d.Get("ignore_unmanaged_owners").(bool)
+		if ignoreUnmanagedOwners {
+			oldOwners, _ := d.GetChange("owners")
+			managedOwners := tf.ExpandStringSlice(oldOwners.(*schema.Set).List())
+			ownersForRemoval = utils.Intersection(ownersForRemoval, managedOwners)
+		}
+
 		if len(ownersToAdd) > 0 {
 			newOwners := make(msgraph.Owners, 0)
 			for _, ownerId := range ownersToAdd {
@@ -1186,6 +1467,16 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 				return tf.ErrorDiagF(err, "Could not remove owners from application with object ID: %q", d.Id())
 			}
 		}
+
+		if len(ownersForRemoval) > 0 || len(ownersToAdd) > 0 {
+			if ignoreUnmanagedOwners {
+				if err := applicationWaitForOwnersDelta(ctx, client, d.Id(), desiredOwners, ownersForRemoval); err != nil {
+					return tf.ErrorDiagF(err, "Waiting for owners of application with object ID %q to be updated", d.Id())
+				}
+			} else if err := applicationWaitForOwners(ctx, client, d.Id(), desiredOwners); err != nil {
+				return tf.ErrorDiagF(err, "Waiting for owners of application with object ID %q to be updated", d.Id())
+			}
+		}
 	}
 
 	// Upload the application image
@@ -1196,7 +1487,30 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	return applicationResourceRead(ctx, d, meta)
+	if d.HasChange("verified_publisher_id") {
+		if diags := applicationSetVerifiedPublisher(ctx, meta.(*clients.Client).Applications.VerifiedPublisherClient, d.Id(), d.Get("verified_publisher_id").(string)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChanges("saml_metadata_url", "token_encryption_key_id") {
+		if _, err := meta.(*clients.Client).Applications.ApplicationSamlSettingsClient.Update(ctx, d.Id(), expandApplicationSamlSettings(d)); err != nil {
+			return tf.ErrorDiagF(err, "Could not update SAML settings for application with object ID: %q", d.Id())
+		}
+	}
+
+	var diags diag.Diagnostics
+	if len(removedAppRoles) > 0 {
+		diags = append(diags, applicationRemovedRolesScopesWarning("app_role", removedAppRoles))
+	}
+	if len(removedScopes) > 0 {
+		diags = append(diags, applicationRemovedRolesScopesWarning("oauth2_permission_scope", removedScopes))
+	}
+	if d.Get("validate_required_resource_access").(bool) && d.HasChanges("required_resource_access", "validate_required_resource_access") {
+		diags = append(diags, applicationValidateRequiredResourceAccess(ctx, meta.(*clients.Client).Applications.ServicePrincipalsClient, d.Get("required_resource_access").(*schema.Set).List())...)
+	}
+
+	return append(diags, applicationResourceRead(ctx, d, meta)...)
 }
 
 func applicationResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1218,14 +1532,15 @@ func applicationResourceRead(ctx context.Context, d *schema.ResourceData, meta i
 	tf.Set(d, "app_role_ids", flattenApplicationAppRoleIDs(app.AppRoles))
 	tf.Set(d, "application_id", app.AppId)
 	tf.Set(d, "device_only_auth_enabled", app.IsDeviceOnlyAuthSupported)
-	tf.Set(d, "disabled_by_microsoft", fmt.Sprintf("%v", app.DisabledByMicrosoftStatus))
+	tf.Set(d, "disabled_by_microsoft", flattenApplicationDisabledByMicrosoft(app.DisabledByMicrosoftStatus))
 	tf.Set(d, "display_name", app.DisplayName)
 	tf.Set(d, "fallback_public_client_enabled", app.IsFallbackPublicClient)
 	tf.Set(d, "feature_tags", helpers.ApplicationFlattenFeatures(app.Tags, false))
-	tf.Set(d, "group_membership_claims", tf.FlattenStringSlicePtr(app.GroupMembershipClaims))
-	tf.Set(d, "identifier_uris", tf.FlattenStringSlicePtr(app.IdentifierUris))
+	tf.Set(d, "group_membership_claims", flattenApplicationGroupMembershipClaims(app.GroupMembershipClaims))
+	tf.Set(d, "identifier_uris", helpers.ApplicationFlattenIdentifierUris(app.IdentifierUris, app.AppId, d.Get("identifier_uris").(*schema.Set).List()))
 	tf.Set(d, "oauth2_post_response_required", app.Oauth2RequirePostResponse)
 	tf.Set(d, "object_id", app.ID)
+	tf.Set(d, "key_credentials", flattenApplicationKeyCredentialsMetadata(app.KeyCredentials))
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaims(app.OptionalClaims))
 	tf.Set(d, "public_client", flattenApplicationPublicClient(app.PublicClient))
 	tf.Set(d, "publisher_domain", app.PublisherDomain)
@@ -1234,12 +1549,44 @@ func applicationResourceRead(ctx context.Context, d *schema.ResourceData, meta i
 	tf.Set(d, "single_page_application", flattenApplicationSpa(app.Spa))
 	tf.Set(d, "tags", app.Tags)
 	tf.Set(d, "template_id", app.ApplicationTemplateId)
+	tf.Set(d, "token_encryption_key_id", app.TokenEncryptionKeyId)
+	tf.Set(d, "verified_publisher", flattenApplicationVerifiedPublisher(app.VerifiedPublisher))
 	tf.Set(d, "web", flattenApplicationWeb(app.Web))
 
+	// SAML settings are retrieved with a separate request which is only relevant to applications using SAML-based
+	// federation, so a failure should not prevent reading other applications. The existing value is retained in that case.
+	if samlSettings, _, err := meta.(*clients.Client).Applications.ApplicationSamlSettingsClient.Get(ctx, d.Id()); err != nil {
+		log.Printf("[WARN] Could not retrieve SAML settings for application with object ID %q: %+v", d.Id(), err)
+	} else {
+		samlMetadataUrl := ""
+		if samlSettings.SamlMetadataUrl != nil {
+			samlMetadataUrl = string(*samlSettings.SamlMetadataUrl)
+		}
+		tf.Set(d, "saml_metadata_url", samlMetadataUrl)
+	}
+
+	verifiedPublisherId := ""
+	if app.VerifiedPublisher != nil && app.VerifiedPublisher.VerifiedPublisherId != nil {
+		verifiedPublisherId = *app.VerifiedPublisher.VerifiedPublisherId
+	}
+	tf.Set(d, "verified_publisher_id", verifiedPublisherId)
+
 	if app.Api != nil {
 		tf.Set(d, "oauth2_permission_scope_ids", flattenApplicationOAuth2PermissionScopeIDs(app.Api.OAuth2PermissionScopes))
 	}
 
+	templateServicePrincipalId := ""
+	if app.ApplicationTemplateId != nil && *app.ApplicationTemplateId != "" && app.AppId != nil {
+		servicePrincipal, err := applicationFindServicePrincipal(ctx, meta.(*clients.Client).Applications.ServicePrincipalsClient, *app.AppId)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve service principal for application with object ID %q", d.Id())
+		}
+		if servicePrincipal != nil && servicePrincipal.ID != nil {
+			templateServicePrincipalId = *servicePrincipal.ID
+		}
+	}
+	tf.Set(d, "template_service_principal_object_id", templateServicePrincipalId)
+
 	if app.Info != nil {
 		tf.Set(d, "logo_url", app.Info.LogoUrl)
 		tf.Set(d, "marketing_url", app.Info.MarketingUrl)
@@ -1260,20 +1607,72 @@ func applicationResourceRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	tf.Set(d, "prevent_duplicate_names", preventDuplicates)
 
+	ignoreUnmanagedOwners := false
+	if v := d.Get("ignore_unmanaged_owners").(bool); v {
+		ignoreUnmanagedOwners = v
+	}
+	tf.Set(d, "ignore_unmanaged_owners", ignoreUnmanagedOwners)
+
+	permanentlyDelete := false
+	if v := d.Get("permanently_delete").(bool); v {
+		permanentlyDelete = v
+	}
+	tf.Set(d, "permanently_delete", permanentlyDelete)
+
+	restoreIfDeleted := false
+	if v := d.Get("restore_if_deleted").(bool); v {
+		restoreIfDeleted = v
+	}
+	tf.Set(d, "restore_if_deleted", restoreIfDeleted)
+
 	owners, _, err := client.ListOwners(ctx, *app.ID)
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for application with object ID %q", *app.ID)
 	}
+
+	// Only consider owners that are known to Terraform, so that any owners added by other means are not removed
+	if ignoreUnmanagedOwners && owners != nil {
+		managedOwners := utils.Intersection(*owners, tf.ExpandStringSlice(d.Get("owners").(*schema.Set).List()))
+		owners = &managedOwners
+	}
 	tf.Set(d, "owners", owners)
 
+	passwordCredentials := flattenApplicationPasswordCredentialsMetadata(app.PasswordCredentials)
+	tf.Set(d, "password_credentials", passwordCredentials)
+
 	return nil
 }
 
+func applicationResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// prevent_duplicate_names is not returned by the API, so set it to its default value to ensure that the first
+	// plan following an import does not show a diff for it
+	if err := d.Set("prevent_duplicate_names", false); err != nil {
+		return nil, fmt.Errorf("setting `prevent_duplicate_names` for imported application: %+v", err)
+	}
+	if err := d.Set("ignore_unmanaged_owners", false); err != nil {
+		return nil, fmt.Errorf("setting `ignore_unmanaged_owners` for imported application: %+v", err)
+	}
+	if err := d.Set("validate_required_resource_access", false); err != nil {
+		return nil, fmt.Errorf("setting `validate_required_resource_access` for imported application: %+v", err)
+	}
+	if err := d.Set("permanently_delete", false); err != nil {
+		return nil, fmt.Errorf("setting `permanently_delete` for imported application: %+v", err)
+	}
+	if err := d.Set("disable_on_destroy", false); err != nil {
+		return nil, fmt.Errorf("setting `disable_on_destroy` for imported application: %+v", err)
+	}
+	if err := d.Set("restore_if_deleted", false); err != nil {
+		return nil, fmt.Errorf("setting `restore_if_deleted` for imported application: %+v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func applicationResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Applications.ApplicationsClient
 	appId := d.Id()
 
-	_, status, err := client.Get(ctx, appId, odata.Query{})
+	app, status, err := client.Get(ctx, appId, odata.Query{})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "id", "Retrieving Application with object ID %q", appId)
@@ -1282,9 +1681,21 @@ func applicationResourceDelete(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagPathF(err, "id", "Retrieving application with object ID %q", appId)
 	}
 
+	// Leave the application in place for audit purposes, but ensure it can no longer be used
+	if d.Get("disable_on_destroy").(bool) {
+		if app == nil || app.AppId == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil application or application with nil application ID"), "Bad API Response")
+		}
+		log.Printf("[INFO] `disable_on_destroy` is set, so application with object ID %q will be disabled instead of being deleted", appId)
+		if err := applicationDisableOnDestroy(ctx, client, meta.(*clients.Client).Applications.ServicePrincipalsClient, appId, *app.AppId); err != nil {
+			return tf.ErrorDiagF(err, "Disabling application with object ID %q", appId)
+		}
+		return nil
+	}
+
 	status, err = client.Delete(ctx, appId)
 	if err != nil {
-		return tf.ErrorDiagPathF(err, "id", "Deleting application with object ID %q, got status %d", appId, status)
+		return tf.ODataErrorDiagPathF(err, "id", "Deleting application with object ID %q, got status %d", appId, status)
 	}
 
 	// Wait for application object to be deleted
@@ -1301,5 +1712,27 @@ func applicationResourceDelete(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagF(err, "Waiting for deletion of application with object ID %q", appId)
 	}
 
+	// The application is now soft-deleted; optionally purge it from deleted items so that its identifier URIs and
+	// other unique properties are released immediately
+	if d.Get("permanently_delete").(bool) {
+		status, err = client.DeletePermanently(ctx, appId)
+		if err != nil {
+			return tf.ODataErrorDiagPathF(err, "id", "Permanently deleting application with object ID %q, got status %d", appId, status)
+		}
+
+		if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+			client.BaseClient.DisableRetries = true
+			if _, status, err := client.GetDeleted(ctx, appId, odata.Query{}); err != nil {
+				if status == http.StatusNotFound {
+					return utils.Bool(false), nil
+				}
+				return nil, err
+			}
+			return utils.Bool(true), nil
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for permanent deletion of application with object ID %q", appId)
+		}
+	}
+
 	return nil
 }
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
@@ -31,6 +33,7 @@ func TestAccApplication_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("application_id").Exists(),
 				check.That(data.ResourceName).Key("object_id").Exists(),
 				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-APP-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("disabled_by_microsoft").IsEmpty(),
 			),
 		},
 		data.ImportStep(),
@@ -50,6 +53,7 @@ func TestAccApplication_basicFromTemplate(t *testing.T) {
 				check.That(data.ResourceName).Key("object_id").Exists(),
 				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-APP-%d", data.RandomInteger)),
 				check.That(data.ResourceName).Key("template_id").HasValue(testApplicationTemplateId),
+				check.That(data.ResourceName).Key("template_service_principal_object_id").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -85,6 +89,7 @@ func TestAccApplication_completeFromTemplate(t *testing.T) {
 				check.That(data.ResourceName).Key("application_id").Exists(),
 				check.That(data.ResourceName).Key("object_id").Exists(),
 				check.That(data.ResourceName).Key("template_id").HasValue(testApplicationTemplateId),
+				check.That(data.ResourceName).Key("template_service_principal_object_id").Exists(),
 				check.That(data.ResourceName).Key("app_role.#").HasValue("1"),
 				check.That(data.ResourceName).Key("app_role.0.id").HasValue(testApplicationTemplateAppRoleId),
 			),
@@ -214,6 +219,42 @@ func TestAccApplication_duplicateAppRolesOauth2PermissionsValues(t *testing.T) {
 	})
 }
 
+func TestAccApplication_oauth2PermissionScopeAdminWithUserConsent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.oauth2PermissionScopeConsentFields(data, "Admin", true),
+			ExpectError: regexp.MustCompile("`oauth2_permission_scope` with value \"administer\" has type \"Admin\", so `user_consent_description` and `user_consent_display_name` must not be specified"),
+		},
+	})
+}
+
+func TestAccApplication_oauth2PermissionScopeUserWithoutUserConsent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.oauth2PermissionScopeConsentFields(data, "User", false),
+			ExpectError: regexp.MustCompile("`oauth2_permission_scope` with value \"administer\" has type \"User\", so both `user_consent_description` and `user_consent_display_name` must be specified"),
+		},
+	})
+}
+
+func TestAccApplication_tooManyRedirectUris(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.redirectUris(data, 100, 100, 57),
+			ExpectError: regexp.MustCompile("a maximum of 256 `redirect_uris` are supported across the `public_client`, `single_page_application` and `web` blocks, but 257 were specified"),
+		},
+	})
+}
+
 func TestAccApplication_groupMembershipClaimsUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -243,106 +284,158 @@ func TestAccApplication_groupMembershipClaimsUpdate(t *testing.T) {
 	})
 }
 
-func TestAccApplication_oauth2PermissionScopes(t *testing.T) {
+func TestAccApplication_groupMembershipClaims(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
-	scopeIDs := []string{
-		data.UUID(),
-		data.UUID(),
-		data.UUID(),
-	}
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.basic(data),
+			Config: r.groupMembershipClaims(data, `["SecurityGroup", "ApplicationGroup"]`),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("0"),
-				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("0"),
+				check.That(data.ResourceName).Key("group_membership_claims.#").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.oauth2PermissionScopes(data, scopeIDs),
+			Config: r.groupMembershipClaims(data, `["All"]`),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("2"),
-				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
+				check.That(data.ResourceName).Key("group_membership_claims.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.oauth2PermissionScopesUpdate(data, scopeIDs),
+			Config: r.groupMembershipClaims(data, `["None"]`),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("3"),
-				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("3"),
+				check.That(data.ResourceName).Key("group_membership_claims.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_groupMembershipClaimsNoneWithOthers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.oauth2PermissionScopes(data, scopeIDs),
+			Config:      r.groupMembershipClaims(data, `["None", "SecurityGroup"]`),
+			ExpectError: regexp.MustCompile("cannot contain \"None\" together with any other value"),
+		},
+	})
+}
+
+func TestAccApplication_credentialsMetadata(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("2"),
-				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
+				check.That(data.ResourceName).Key("key_credentials.#").HasValue("0"),
+				check.That(data.ResourceName).Key("password_credentials.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
 		{
-			Config: r.basic(data),
+			// The password is added by a separate resource, so is not reflected until the application is next refreshed
+			Config: r.withPassword(data),
+		},
+		{
+			Config: r.withPassword(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("0"),
-				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("0"),
+				check.That(data.ResourceName).Key("password_credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("password_credentials.0.key_id").MatchesOtherKey(check.That("azuread_application_password.test").Key("key_id")),
+				check.That(data.ResourceName).Key("password_credentials.0.display_name").HasValue(fmt.Sprintf("acctest-APP-password-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("password_credentials.0.end_date").Exists(),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
-func TestAccApplication_owners(t *testing.T) {
+func TestAccApplication_optionalClaimsGroups(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.basic(data),
+			Config: r.optionalClaimsGroups(data, "groups"),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.#").HasValue("1"),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.0.name").HasValue("groups"),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.0.additional_properties.#").HasValue("2"),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.0.additional_properties.0").HasValue("emit_as_roles"),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.0.additional_properties.1").HasValue("sam_account_name"),
+				check.That(data.ResourceName).Key("optional_claims.0.id_token.0.additional_properties.#").HasValue("1"),
+				check.That(data.ResourceName).Key("optional_claims.0.id_token.0.additional_properties.0").HasValue("dns_domain_and_sam_account_name"),
 			),
 		},
 		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_optionalClaimsGroupPropertiesOnOtherClaim(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.singleOwner(data),
+			Config:      r.optionalClaimsGroups(data, "upn"),
+			ExpectError: regexp.MustCompile("can only be specified for the `groups` claim"),
+		},
+	})
+}
+
+func TestAccApplication_oauth2PermissionScopes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	scopeIDs := []string{
+		data.UUID(),
+		data.UUID(),
+		data.UUID(),
+	}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("0"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.noOwners(data),
+			Config: r.oauth2PermissionScopes(data, scopeIDs),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("2"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.singleOwner(data),
+			Config: r.oauth2PermissionScopesUpdate(data, scopeIDs),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("3"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("3"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.threeOwners(data),
+			Config: r.oauth2PermissionScopes(data, scopeIDs),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("3"),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("2"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
@@ -350,258 +443,1425 @@ func TestAccApplication_owners(t *testing.T) {
 			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("0"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
-func TestAccApplication_createWithNoOwners(t *testing.T) {
+func TestAccApplication_oauth2PermissionScopeRenameAndChangeId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
+	scopeIDs := []string{
+		data.UUID(),
+		data.UUID(),
+	}
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.noOwners(data),
+			Config: r.oauth2PermissionScope(data, scopeIDs[0], "read"),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.read").HasValue(scopeIDs[0]),
 			),
 		},
 		data.ImportStep(),
-	})
-}
-
-func TestAccApplication_manyOwners(t *testing.T) {
-	data := acceptance.BuildTestData(t, "azuread_application", "test")
-	r := ApplicationResource{}
-
-	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.manyOwners(data),
+			// Renaming a scope whilst retaining its ID is permitted
+			Config: r.oauth2PermissionScope(data, scopeIDs[0], "read_all"),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("owners.#").HasValue("45"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.read_all").HasValue(scopeIDs[0]),
 			),
 		},
 		data.ImportStep(),
+		{
+			// Changing the ID of an existing scope is not permitted
+			Config:      r.oauth2PermissionScope(data, scopeIDs[1], "read_all"),
+			ExpectError: regexp.MustCompile("the `id` of the existing `oauth2_permission_scope` with value \"read_all\" cannot be changed"),
+		},
 	})
 }
 
-func TestAccApplication_preventDuplicateNamesPass(t *testing.T) {
+func TestAccApplication_oauth2PermissionScopeGeneratedId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.preventDuplicateNamesPass(data),
+			Config: r.oauth2PermissionScopeWithoutId(data, "read"),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("1"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.read").IsUuid(),
 			),
 		},
-		data.ImportStep("prevent_duplicate_names"),
-	})
-}
-
-func TestAccApplication_preventDuplicateNamesFail(t *testing.T) {
-	data := acceptance.BuildTestData(t, "azuread_application", "test")
-	r := ApplicationResource{}
-
-	data.ResourceTest(t, r, []resource.TestStep{
-		data.RequiresImportErrorStep(r.preventDuplicateNamesFail(data)),
+		{
+			// The derived ID must be stable, so a subsequent plan should be empty
+			Config:   r.oauth2PermissionScopeWithoutId(data, "read"),
+			PlanOnly: true,
+		},
+		data.ImportStep(),
+		{
+			Config:   r.oauth2PermissionScopeWithoutId(data, "read"),
+			PlanOnly: true,
+		},
 	})
 }
 
-func TestAccApplication_related(t *testing.T) {
+func TestAccApplication_oauth2PermissionScopeDisableThenRemove(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
-	uuids := []string{
-		data.UUID(),
-		data.UUID(),
-		data.UUID(),
-		data.UUID(),
-	}
+	scopeID := data.UUID()
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.related(data, uuids),
+			Config: r.oauth2PermissionScopeEnabled(data, scopeID, true),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.relatedUpdate(data, uuids),
+			Config: r.oauth2PermissionScopeEnabled(data, scopeID, false),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
-	})
-}
-
-func TestAccApplication_featureTags(t *testing.T) {
-	data := acceptance.BuildTestData(t, "azuread_application", "test")
-	r := ApplicationResource{}
-
-	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.featureTags(data),
+			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
+		{
+			// Removing an enabled scope disables it in a separate update prior to removal
+			Config: r.oauth2PermissionScopeEnabled(data, scopeID, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("0"),
+			),
+		},
 	})
 }
 
-func TestAccApplication_featureTagsUpdate(t *testing.T) {
+func TestAccApplication_oauth2PermissionScopeConsentedRemoval(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
+	scopeID := data.UUID()
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.noFeatureTags(data),
+			Config: r.oauth2PermissionScopeConsented(data, scopeID, true),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("1"),
 			),
 		},
-		data.ImportStep(),
 		{
-			Config: r.basic(data),
+			// Removing a consented scope which is still enabled should be refused
+			Config:      r.oauth2PermissionScopeConsentedRemoved(data),
+			ExpectError: regexp.MustCompile("the following enabled `oauth2_permission_scope` blocks are being removed: read"),
+		},
+		{
+			Config: r.oauth2PermissionScopeConsented(data, scopeID, false),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("1"),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.0.enabled").HasValue("false"),
 			),
 		},
-		data.ImportStep(),
 		{
-			Config: r.featureTags(data),
+			Config: r.oauth2PermissionScopeConsentedRemoved(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.oauth2_permission_scope.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_owners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
 		{
 			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.featureTags(data),
+			Config: r.singleOwner(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.tags(data),
+			Config: r.noOwners(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.featureTags(data),
+			Config: r.singleOwner(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.noFeatureTags(data),
+			Config: r.threeOwners(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("3"),
 			),
 		},
 		data.ImportStep(),
 		{
-			Config: r.featureTags(data),
+			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
-func TestAccApplication_logo(t *testing.T) {
+func TestAccApplication_importWithOwners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	var applicationId string
+
+	data.ResourceTestIgnoreDangling(t, r, []resource.TestStep{
+		{
+			Config: r.templateThreeUsers(data),
+			Check:  r.createWithOwnersOutOfBand(data, &applicationId),
+		},
+		{
+			Config:       r.threeOwners(data),
+			ResourceName: data.ResourceName,
+			ImportState:  true,
+			ImportStateIdFunc: func(*terraform.State) (string, error) {
+				return applicationId, nil
+			},
+			ImportStateCheck: func(states []*terraform.InstanceState) error {
+				if len(states) != 1 {
+					return fmt.Errorf("expected 1 imported state, got %d", len(states))
+				}
+				attrs := states[0].Attributes
+				if v := attrs["owners.#"]; v != "3" {
+					return fmt.Errorf("expected 3 owners after import, got %q", v)
+				}
+				if v := attrs["prevent_duplicate_names"]; v != "false" {
+					return fmt.Errorf("expected `prevent_duplicate_names` to be \"false\" after import, got %q", v)
+				}
+				return nil
+			},
+		},
+		{
+			Config: r.templateThreeUsers(data),
+			Check:  r.deleteOutOfBand(&applicationId),
+		},
+	})
+}
+
+func TestAccApplication_ignoreUnmanagedOwners(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
 
 	data.ResourceTest(t, r, []resource.TestStep{
 		{
-			Config: r.logo(data),
+			Config: r.singleOwnerIgnoreUnmanaged(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				r.addOwnerOutOfBand(data, "azuread_user.testB"),
 			),
 		},
-		data.ImportStep("logo"),
 		{
-			Config: r.basic(data),
+			Config: r.singleOwnerIgnoreUnmanaged(data),
 			Check: resource.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				r.hasOwner(data, "azuread_user.testB"),
 			),
 		},
-		data.ImportStep(),
 		{
-			Config: r.logo(data),
+			Config: r.twoOwnersIgnoreUnmanaged(data),
 			Check: resource.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("2"),
+				r.hasOwner(data, "azuread_user.testB"),
+			),
+		},
+		{
+			Config: r.singleOwnerIgnoreUnmanaged(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				r.hasOwner(data, "azuread_user.testB"),
 			),
 		},
-		data.ImportStep("logo"),
 	})
 }
 
-func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Applications.ApplicationsClient
-	client.BaseClient.DisableRetries = true
-	app, status, err := client.Get(ctx, state.ID, odata.Query{})
+func TestAccApplication_createWithNoOwners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.noOwners(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_manyOwners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.manyOwners(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("45"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_preventDuplicateNamesPass(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.preventDuplicateNamesPass(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("prevent_duplicate_names"),
+	})
+}
+
+func TestAccApplication_preventDuplicateNamesFail(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		data.RequiresImportErrorStep(r.preventDuplicateNamesFail(data)),
+	})
+}
+
+func TestAccApplication_permanentlyDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.permanentlyDelete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permanently_delete").HasValue("true"),
+			),
+		},
+		data.ImportStep("permanently_delete"),
+		{
+			Config: `provider "azuread" {}`,
+		},
+		{
+			// Recreating immediately with the same identifier URI would conflict with a soft-deleted application
+			Config: r.permanentlyDelete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccApplication_disableOnDestroy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	var applicationId string
+
+	data.ResourceTestIgnoreDangling(t, r, []resource.TestStep{
+		{
+			Config: r.disableOnDestroy(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("1"),
+				r.objectId(data, &applicationId, false),
+			),
+		},
+		data.ImportStep("disable_on_destroy"),
+		{
+			// Removing the application from the configuration should leave it in place, but disabled
+			Config: `provider "azuread" {}`,
+			Check: resource.ComposeTestCheckFunc(
+				r.isDisabled(&applicationId),
+				r.deleteOutOfBand(&applicationId),
+			),
+		},
+	})
+}
+
+func TestAccApplication_restoreIfDeleted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	var applicationId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.restoreIfDeleted(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.objectId(data, &applicationId, false),
+			),
+		},
+		{
+			Config: `provider "azuread" {}`,
+		},
+		{
+			Config: r.restoreIfDeleted(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.objectId(data, &applicationId, true),
+			),
+		},
+		data.ImportStep("restore_if_deleted"),
+	})
+}
+
+func TestAccApplication_logoutUrl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.logoutUrl(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.logout_url").HasValue(fmt.Sprintf("https://acctest-app-%d.example.com/saml/logout", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_webUrlsClear(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.webUrls(data, fmt.Sprintf("https://acctest-app-%d.example.com", data.RandomInteger), fmt.Sprintf("https://acctest-app-%d.example.com/logout", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.homepage_url").HasValue(fmt.Sprintf("https://acctest-app-%d.example.com", data.RandomInteger)),
+				check.That(data.ResourceName).Key("web.0.logout_url").HasValue(fmt.Sprintf("https://acctest-app-%d.example.com/logout", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.webUrls(data, "", ""),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.homepage_url").IsEmpty(),
+				check.That(data.ResourceName).Key("web.0.logout_url").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_removePlatforms(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.platforms(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_client.0.redirect_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("single_page_application.0.redirect_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("web.0.homepage_url").HasValue(fmt.Sprintf("https://acctest-app-%d.example.com", data.RandomInteger)),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_client.0.redirect_uris.#").HasValue("0"),
+				check.That(data.ResourceName).Key("single_page_application.0.redirect_uris.#").HasValue("0"),
+				check.That(data.ResourceName).Key("web.0.homepage_url").IsEmpty(),
+				check.That(data.ResourceName).Key("web.0.logout_url").IsEmpty(),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("0"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.id_token_issuance_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_implicitGrantToggle(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.implicitGrant(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.id_token_issuance_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.implicitGrant(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.id_token_issuance_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.implicitGrant(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.id_token_issuance_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_validateRequiredResourceAccess(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.validateRequiredResourceAccess(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("validate_required_resource_access"),
+	})
+}
+
+func TestAccApplication_samlMetadataUrl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.samlMetadataUrl(data, "metadata"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_metadata_url").HasValue(fmt.Sprintf("https://acctest-app-%d.example.com/saml/metadata", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.samlMetadataUrl(data, "federationmetadata.xml"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_metadata_url").HasValue(fmt.Sprintf("https://acctest-app-%d.example.com/saml/federationmetadata.xml", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_metadata_url").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_optionalClaimsDirectoryExtension(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.optionalClaimsDirectoryExtension(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.#").HasValue("2"),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.1.source").HasValue("user"),
+				check.That(data.ResourceName).Key("optional_claims.0.access_token.1.essential").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_identifierUrisReordered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.identifierUris(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:   r.identifierUris(data, true),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccApplication_related(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	uuids := []string{
+		data.UUID(),
+		data.UUID(),
+		data.UUID(),
+		data.UUID(),
+	}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.related(data, uuids),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.relatedUpdate(data, uuids),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_signInAudienceMigration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	var objectId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.signInAudience(data, "AzureADMyOrg", fmt.Sprintf("https://acctest-app-%d.example.com/api", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADMyOrg"),
+				r.objectId(data, &objectId, false),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.signInAudience(data, "AzureADMultipleOrgs", fmt.Sprintf("api://acctest-app-%d", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADMultipleOrgs"),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				check.That("azuread_application_password.test").ExistsInAzure(ApplicationPasswordResource{}),
+				r.objectId(data, &objectId, true),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.signInAudience(data, "AzureADMyOrg", fmt.Sprintf("api://acctest-app-%d", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADMyOrg"),
+				check.That("azuread_application_password.test").ExistsInAzure(ApplicationPasswordResource{}),
+				r.objectId(data, &objectId, true),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_signInAudienceMigrationInvalidIdentifierUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.signInAudience(data, "AzureADMyOrg", fmt.Sprintf("https://acctest-app-%d.example.com/api", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.signInAudience(data, "AzureADMultipleOrgs", fmt.Sprintf("https://acctest-app-%d.example.com/api", data.RandomInteger)),
+			ExpectError: regexp.MustCompile("To change the sign-in audience manually"),
+		},
+	})
+}
+
+func TestAccApplication_featureTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.featureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_publisherDomain(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.publisherDomain(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				resource.TestCheckResourceAttrPair(data.ResourceName, "publisher_domain", "data.azuread_domains.test", "domains.0.domain_name"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_publisherDomainNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.publisherDomainNotFound(data),
+			ExpectError: regexp.MustCompile("was not found in this tenant"),
+		},
+	})
+}
+
+func TestAccApplication_featureTagsUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.noFeatureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.featureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.featureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.featureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.noFeatureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.featureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_featureTagsPreserveExternalTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	var applicationId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.noFeatureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.objectId(data, &applicationId, false),
+				r.addTagOutOfBand(&applicationId, "acctestExternalTag"),
+			),
+		},
+		{
+			Config: r.featureTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.#").HasValue("5"),
+				resource.TestCheckTypeSetElemAttr(data.ResourceName, "tags.*", "acctestExternalTag"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_logo(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.logo(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("logo"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.logo(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("logo"),
+	})
+}
+
+func TestAccApplication_verifiedPublisherIneligible(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.verifiedPublisher(data, "1234567"),
+			ExpectError: regexp.MustCompile("is not eligible to be the verified publisher for application"),
+		},
+	})
+}
+
+func TestAccApplication_concurrentSubResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	// Terraform applies independent resources in parallel, so all of these sub-resources will be
+	// updating the same application concurrently. Each is checked against the API to catch lost updates.
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.concurrentSubResources(data, data.UUID(), data.UUID()),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_application_app_role.test").ExistsInAzure(ApplicationAppRoleResource{}),
+				check.That("azuread_application_federated_identity_credential.test").ExistsInAzure(ApplicationFederatedIdentityCredentialResource{}),
+				check.That("azuread_application_identifier_uri.first").ExistsInAzure(ApplicationIdentifierUriResource{}),
+				check.That("azuread_application_identifier_uri.second").ExistsInAzure(ApplicationIdentifierUriResource{}),
+				check.That("azuread_application_pre_authorized.test").ExistsInAzure(ApplicationPreAuthorizedResource{}),
+			),
+		},
+	})
+}
+
+func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationsClient
+	client.BaseClient.DisableRetries = true
+	app, status, err := client.Get(ctx, state.ID, odata.Query{})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return nil, fmt.Errorf("Application with object ID %q does not exist", state.ID)
 		}
-		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", state.ID, err)
+		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", state.ID, err)
+	}
+	return utils.Bool(app.ID != nil && *app.ID == state.ID), nil
+}
+
+func (ApplicationResource) createWithOwnersOutOfBand(data acceptance.TestData, applicationId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Applications.ApplicationsClient
+
+		owners := make(msgraph.Owners, 0)
+		for _, name := range []string{"azuread_user.testA", "azuread_user.testB", "azuread_user.testC"} {
+			rs, ok := s.RootModule().Resources[name]
+			if !ok {
+				return fmt.Errorf("%q was not found in the state", name)
+			}
+			ownerId := rs.Primary.ID
+			owners = append(owners, msgraph.DirectoryObject{
+				ODataId: (*odata.Id)(utils.String(fmt.Sprintf("%s/v1.0/%s/directoryObjects/%s",
+					client.BaseClient.Endpoint, client.BaseClient.TenantId, ownerId))),
+				ID: &ownerId,
+			})
+		}
+
+		app, _, err := client.Create(clients.StopContext, msgraph.Application{
+			DisplayName: utils.String(fmt.Sprintf("acctest-APP-%d", data.RandomInteger)),
+			Owners:      &owners,
+		})
+		if err != nil {
+			return fmt.Errorf("creating application out-of-band: %+v", err)
+		}
+		if app.ID == nil {
+			return fmt.Errorf("creating application out-of-band: returned object ID was nil")
+		}
+
+		*applicationId = *app.ID
+		return nil
+	}
+}
+
+func (ApplicationResource) addOwnerOutOfBand(data acceptance.TestData, ownerResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Applications.ApplicationsClient
+
+		app, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		owner, ok := s.RootModule().Resources[ownerResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", ownerResourceName)
+		}
+
+		ownerId := owner.Primary.ID
+		if _, err := client.AddOwners(clients.StopContext, &msgraph.Application{
+			DirectoryObject: msgraph.DirectoryObject{
+				ID: utils.String(app.Primary.ID),
+			},
+			Owners: &msgraph.Owners{
+				msgraph.DirectoryObject{
+					ODataId: (*odata.Id)(utils.String(fmt.Sprintf("%s/v1.0/%s/directoryObjects/%s",
+						client.BaseClient.Endpoint, client.BaseClient.TenantId, ownerId))),
+					ID: &ownerId,
+				},
+			},
+		}); err != nil {
+			return fmt.Errorf("adding owner %q to application out-of-band: %+v", ownerId, err)
+		}
+
+		return nil
 	}
-	return utils.Bool(app.ID != nil && *app.ID == state.ID), nil
 }
 
-func (ApplicationResource) basic(data acceptance.TestData) string {
-	return fmt.Sprintf(`
-provider "azuread" {}
+func (ApplicationResource) hasOwner(data acceptance.TestData, ownerResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Applications.ApplicationsClient
+
+		app, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		owner, ok := s.RootModule().Resources[ownerResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", ownerResourceName)
+		}
+
+		owners, _, err := client.ListOwners(clients.StopContext, app.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("listing owners for application with object ID %q: %+v", app.Primary.ID, err)
+		}
+		if owners != nil {
+			for _, ownerId := range *owners {
+				if ownerId == owner.Primary.ID {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("expected %q to be an owner of application with object ID %q", owner.Primary.ID, app.Primary.ID)
+	}
+}
+
+// objectId records the object ID of the application in the state, or when compare is true, checks that it is unchanged
+func (ApplicationResource) objectId(data acceptance.TestData, objectId *string, compare bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		app, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		if compare && app.Primary.ID != *objectId {
+			return fmt.Errorf("expected application to be restored with object ID %q, got %q", *objectId, app.Primary.ID)
+		}
+		*objectId = app.Primary.ID
+		return nil
+	}
+}
+
+func (ApplicationResource) isDisabled(applicationId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Applications.ApplicationsClient
+		servicePrincipalsClient := clients.Applications.ServicePrincipalsClient
+
+		app, _, err := client.Get(clients.StopContext, *applicationId, odata.Query{})
+		if err != nil {
+			return fmt.Errorf("expected application with object ID %q to be retained: %+v", *applicationId, err)
+		}
+		if app.IdentifierUris != nil && len(*app.IdentifierUris) > 0 {
+			return fmt.Errorf("expected identifier URIs to be removed from application with object ID %q, got %v", *applicationId, *app.IdentifierUris)
+		}
+		if app.AppId == nil {
+			return fmt.Errorf("application with object ID %q has nil application ID", *applicationId)
+		}
+
+		servicePrincipals, _, err := servicePrincipalsClient.List(clients.StopContext, odata.Query{Filter: fmt.Sprintf("appId eq '%s'", *app.AppId)})
+		if err != nil {
+			return fmt.Errorf("listing service principals for application with object ID %q: %+v", *applicationId, err)
+		}
+		if servicePrincipals == nil || len(*servicePrincipals) == 0 {
+			return fmt.Errorf("expected a disabled service principal for application with object ID %q, but none was found", *applicationId)
+		}
+		for _, sp := range *servicePrincipals {
+			if sp.AccountEnabled == nil || *sp.AccountEnabled {
+				return fmt.Errorf("expected sign-in to be disabled for service principal for application with object ID %q", *applicationId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func (ApplicationResource) addTagOutOfBand(applicationId *string, tag string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Applications.ApplicationsClient
+
+		app, _, err := client.Get(clients.StopContext, *applicationId, odata.Query{})
+		if err != nil {
+			return fmt.Errorf("retrieving application with object ID %q: %+v", *applicationId, err)
+		}
+		tags := []string{tag}
+		if app.Tags != nil {
+			tags = append(*app.Tags, tag)
+		}
+		if _, err := client.Update(clients.StopContext, msgraph.Application{
+			DirectoryObject: msgraph.DirectoryObject{
+				ID: applicationId,
+			},
+			Tags: &tags,
+		}); err != nil {
+			return fmt.Errorf("adding tag to application with object ID %q: %+v", *applicationId, err)
+		}
+		return nil
+	}
+}
+
+func (ApplicationResource) deleteOutOfBand(applicationId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Applications.ApplicationsClient
+
+		if _, err := client.Delete(clients.StopContext, *applicationId); err != nil {
+			return fmt.Errorf("deleting application with object ID %q: %+v", *applicationId, err)
+		}
+		return nil
+	}
+}
+
+func (ApplicationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) publisherDomain(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  publisher_domain = data.azuread_domains.test.domains.0.domain_name
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) publisherDomainNotFound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  publisher_domain = "acctest-%[1]d.invalid"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) basicFromTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+  template_id  = "%[2]s"
+}
+`, data.RandomInteger, testApplicationTemplateId)
+}
+
+func (ApplicationResource) withGroupMembershipClaims(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-%[1]d"
+  group_membership_claims = ["DirectoryRole", "SecurityGroup", "ApplicationGroup"]
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) groupMembershipClaims(data acceptance.TestData, claims string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-%[1]d"
+  group_membership_claims = %[2]s
+}
+`, data.RandomInteger, claims)
+}
+
+func (ApplicationResource) withPassword(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+}
+
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application.test.object_id
+  display_name          = "acctest-APP-password-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) optionalClaimsGroups(data acceptance.TestData, claimName string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-%[1]d"
+  group_membership_claims = ["SecurityGroup"]
+
+  optional_claims {
+    access_token {
+      name                  = "%[2]s"
+      additional_properties = ["emit_as_roles", "sam_account_name"]
+    }
+
+    id_token {
+      name                  = "%[2]s"
+      additional_properties = ["dns_domain_and_sam_account_name"]
+    }
+  }
+}
+`, data.RandomInteger, claimName)
+}
+
+func (ApplicationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_application" "known1" {
+  display_name = "acctest-APP-known1-%[1]d"
+}
+
+resource "azuread_application" "known2" {
+  display_name = "acctest-APP-known2-%[1]d"
+}
+
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-complete-%[1]d"
+  group_membership_claims = ["All"]
+  sign_in_audience        = "AzureADandPersonalMicrosoftAccount"
+
+  identifier_uris = [
+    "api://hashicorptestapp-%[1]d",
+    "api://acctest-APP-complete-%[1]d",
+  ]
+
+  device_only_auth_enabled       = true
+  fallback_public_client_enabled = true
+  oauth2_post_response_required  = true
+
+  marketing_url         = "https://hashitown-%[1]d.com/"
+  privacy_statement_url = "https://hashitown-%[1]d.com/privacy"
+  support_url           = "https://support.hashitown-%[1]d.com/"
+  terms_of_service_url  = "https://hashitown-%[1]d.com/terms"
+
+  api {
+    mapped_claims_enabled          = true
+    requested_access_token_version = 2
+
+    known_client_applications = [
+      azuread_application.known1.application_id,
+      azuread_application.known2.application_id,
+    ]
+
+    oauth2_permission_scope {
+      admin_consent_description  = "Administer the application"
+      admin_consent_display_name = "Administer"
+      enabled                    = true
+      id                         = "%[3]s"
+      type                       = "Admin"
+      value                      = "administer"
+    }
+
+    oauth2_permission_scope {
+      admin_consent_description  = "Allow the application to access acctest-APP-%[1]d on behalf of the signed-in user."
+      admin_consent_display_name = "Access acctest-APP-%[1]d"
+      enabled                    = true
+      id                         = "%[4]s"
+      type                       = "User"
+      user_consent_description   = "Allow the application to access acctest-APP-%[1]d on your behalf."
+      user_consent_display_name  = "Access acctest-APP-%[1]d"
+      value                      = "user_impersonation"
+    }
+  }
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Admins can manage roles and perform all task actions"
+    display_name         = "Admin"
+    enabled              = true
+    id                   = "%[5]s"
+    value                = "admin"
+  }
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "ReadOnly roles have limited query access"
+    display_name         = "ReadOnly"
+    enabled              = true
+    id                   = "%[6]s"
+    value                = "user"
+  }
+
+  optional_claims {
+    access_token {
+      name = "myclaim"
+    }
+
+    access_token {
+      name = "otherclaim"
+    }
+
+    id_token {
+      name                  = "userclaim"
+      source                = "user"
+      essential             = true
+      additional_properties = ["emit_as_roles"]
+    }
+
+    saml2_token {
+      name = "samlexample"
+    }
+  }
+
+  public_client {
+    redirect_uris = [
+      "myapp://auth",
+      "sample.mobile.app.bundie.id://auth",
+      "https://login.microsoftonline.com/common/oauth2/nativeclient",
+      "https://login.live.com/oauth20_desktop.srf",
+      "ms-appx-web://Microsoft.AAD.BrokerPlugin/00000000-1111-1111-1111-222222222222",
+      "urn:ietf:wg:oauth:2.0:foo",
+    ]
+  }
+
+  required_resource_access {
+    resource_app_id = "00000003-0000-0000-c000-000000000000"
+
+    resource_access {
+      id   = "7ab1d382-f21e-4acd-a863-ba3e13f7da61"
+      type = "Role"
+    }
+
+    resource_access {
+      id   = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
+      type = "Scope"
+    }
 
-resource "azuread_application" "test" {
-  display_name = "acctest-APP-%[1]d"
-}
-`, data.RandomInteger)
-}
+    resource_access {
+      id   = "06da0dbc-49e2-44d2-8312-53f166ab848a"
+      type = "Scope"
+    }
+  }
 
-func (ApplicationResource) basicFromTemplate(data acceptance.TestData) string {
-	return fmt.Sprintf(`
-provider "azuread" {}
+  required_resource_access {
+    resource_app_id = "00000002-0000-0000-c000-000000000000"
 
-resource "azuread_application" "test" {
-  display_name = "acctest-APP-%[1]d"
-  template_id  = "%[2]s"
-}
-`, data.RandomInteger, testApplicationTemplateId)
-}
+    resource_access {
+      id   = "311a71cc-e848-46a1-bdf8-97ff7156d8e6"
+      type = "Scope"
+    }
+  }
 
-func (ApplicationResource) withGroupMembershipClaims(data acceptance.TestData) string {
-	return fmt.Sprintf(`
-provider "azuread" {}
+  single_page_application {
+    redirect_uris = [
+      "https://beta.hashitown-%[1]d.com/",
+    ]
+  }
 
-resource "azuread_application" "test" {
-  display_name            = "acctest-APP-%[1]d"
-  group_membership_claims = ["DirectoryRole", "SecurityGroup", "ApplicationGroup"]
+  tags = [
+    "HideApp",
+    "WindowsAzureActiveDirectoryCustomSingleSignOnApplication",
+    "WindowsAzureActiveDirectoryIntegratedApp",
+    "WindowsAzureActiveDirectoryGalleryApplicationNonPrimaryV1",
+  ]
+
+  web {
+    homepage_url = "https://app.hashitown-%[1]d.com/"
+    logout_url   = "https://app.hashitown-%[1]d.com/logout"
+
+    redirect_uris = [
+      "https://app.hashitown-%[1]d.com/",
+      "https://classic.hashitown-%[1]d.com/",
+      "urn:ietf:wg:oauth:2.0:oob",
+    ]
+
+    implicit_grant {
+      access_token_issuance_enabled = true
+      id_token_issuance_enabled     = true
+    }
+  }
+
+  owners = [azuread_user.test.object_id]
 }
-`, data.RandomInteger)
+`, data.RandomInteger, data.RandomPassword, data.UUID(), data.UUID(), data.UUID(), data.UUID())
 }
 
-func (ApplicationResource) complete(data acceptance.TestData) string {
+func (ApplicationResource) completeFromTemplate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
@@ -625,6 +1885,7 @@ resource "azuread_application" "known2" {
 
 resource "azuread_application" "test" {
   display_name            = "acctest-APP-complete-%[1]d"
+  template_id             = "%[3]s"
   group_membership_claims = ["All"]
   sign_in_audience        = "AzureADandPersonalMicrosoftAccount"
 
@@ -637,10 +1898,10 @@ resource "azuread_application" "test" {
   fallback_public_client_enabled = true
   oauth2_post_response_required  = true
 
-  marketing_url         = "https://hashitown-%[1]d.com/"
-  privacy_statement_url = "https://hashitown-%[1]d.com/privacy"
-  support_url           = "https://support.hashitown-%[1]d.com/"
-  terms_of_service_url  = "https://hashitown-%[1]d.com/terms"
+  marketing_url         = "https://templatetown-%[1]d.com/"
+  privacy_statement_url = "https://templatetown-%[1]d.com/privacy"
+  support_url           = "https://support.templatetown-%[1]d.com/"
+  terms_of_service_url  = "https://templatetown-%[1]d.com/terms"
 
   api {
     mapped_claims_enabled          = true
@@ -655,7 +1916,7 @@ resource "azuread_application" "test" {
       admin_consent_description  = "Administer the application"
       admin_consent_display_name = "Administer"
       enabled                    = true
-      id                         = "%[3]s"
+      id                         = "%[5]s"
       type                       = "Admin"
       value                      = "administer"
     }
@@ -664,7 +1925,7 @@ resource "azuread_application" "test" {
       admin_consent_description  = "Allow the application to access acctest-APP-%[1]d on behalf of the signed-in user."
       admin_consent_display_name = "Access acctest-APP-%[1]d"
       enabled                    = true
-      id                         = "%[4]s"
+      id                         = "%[6]s"
       type                       = "User"
       user_consent_description   = "Allow the application to access acctest-APP-%[1]d on your behalf."
       user_consent_display_name  = "Access acctest-APP-%[1]d"
@@ -674,20 +1935,10 @@ resource "azuread_application" "test" {
 
   app_role {
     allowed_member_types = ["User"]
-    description          = "Admins can manage roles and perform all task actions"
-    display_name         = "Admin"
-    enabled              = true
-    id                   = "%[5]s"
-    value                = "admin"
-  }
-
-  app_role {
-    allowed_member_types = ["User"]
-    description          = "ReadOnly roles have limited query access"
-    display_name         = "ReadOnly"
+    description          = "msiam_access"
+    display_name         = "msiam_access"
     enabled              = true
-    id                   = "%[6]s"
-    value                = "user"
+    id                   = "%[4]s"
   }
 
   optional_claims {
@@ -711,17 +1962,6 @@ resource "azuread_application" "test" {
     }
   }
 
-  public_client {
-    redirect_uris = [
-      "myapp://auth",
-      "sample.mobile.app.bundie.id://auth",
-      "https://login.microsoftonline.com/common/oauth2/nativeclient",
-      "https://login.live.com/oauth20_desktop.srf",
-      "ms-appx-web://Microsoft.AAD.BrokerPlugin/00000000-1111-1111-1111-222222222222",
-      "urn:ietf:wg:oauth:2.0:foo",
-    ]
-  }
-
   required_resource_access {
     resource_app_id = "00000003-0000-0000-c000-000000000000"
 
@@ -744,365 +1984,543 @@ resource "azuread_application" "test" {
   required_resource_access {
     resource_app_id = "00000002-0000-0000-c000-000000000000"
 
-    resource_access {
-      id   = "311a71cc-e848-46a1-bdf8-97ff7156d8e6"
-      type = "Scope"
+    resource_access {
+      id   = "311a71cc-e848-46a1-bdf8-97ff7156d8e6"
+      type = "Scope"
+    }
+  }
+
+  single_page_application {
+    redirect_uris = [
+      "https://beta.templatetown-%[1]d.com/",
+    ]
+  }
+
+  tags = [
+    "WindowsAzureActiveDirectoryCustomSingleSignOnApplication",
+    "WindowsAzureActiveDirectoryIntegratedApp",
+    "WindowsAzureActiveDirectoryGalleryApplicationNonPrimaryV1",
+  ]
+
+  web {
+    homepage_url = "https://app.templatetown-%[1]d.com/"
+    logout_url   = "https://app.templatetown-%[1]d.com/logout"
+
+    redirect_uris = [
+      "https://app.templatetown-%[1]d.com/",
+      "https://classic.templatetown-%[1]d.com/",
+    ]
+
+    implicit_grant {
+      access_token_issuance_enabled = true
+      id_token_issuance_enabled     = true
+    }
+  }
+
+  owners = [azuread_user.test.object_id]
+}
+`, data.RandomInteger, data.RandomPassword, testApplicationTemplateId, testApplicationTemplateAppRoleId, data.UUID(), data.UUID())
+}
+
+func (ApplicationResource) appRole(data acceptance.TestData, roleIDs []string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  app_role {
+    allowed_member_types = ["User", "Application"]
+    description          = "Admins can manage roles and perform all task actions"
+    display_name         = "Admin"
+    enabled              = true
+    id                   = "%[2]s"
+    value                = "admin"
+  }
+}
+`, data.RandomInteger, roleIDs[0])
+}
+
+func (ApplicationResource) appRoleNoValue(data acceptance.TestData, roleIDs []string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Admins can manage roles and perform all task actions"
+    display_name         = "Admin"
+    id                   = "%[2]s"
+    enabled              = true
+  }
+}
+`, data.RandomInteger, roleIDs[0])
+}
+
+func (ApplicationResource) appRolesUpdate(data acceptance.TestData, roleIDs []string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestApp-%[1]d"
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Admins can manage roles and perform all task actions"
+    display_name         = "Admin"
+    enabled              = true
+    id                   = "%[2]s"
+    value                = "admin"
+  }
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "ReadOnly roles have limited query access"
+    display_name         = "ReadOnly"
+    enabled              = true
+    id                   = "%[3]s"
+    value                = "user"
+  }
+}
+`, data.RandomInteger, roleIDs[0], roleIDs[1])
+}
+
+func (ApplicationResource) oauth2PermissionScopes(data acceptance.TestData, scopeIDs []string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Allow the application to access acctest-APP-%[1]d on behalf of the signed-in user."
+      admin_consent_display_name = "Access acctest-APP-%[1]d"
+      enabled                    = true
+      id                         = "%[2]s"
+      type                       = "User"
+      user_consent_description   = "Allow the application to access acctest-APP-%[1]d on your behalf."
+      user_consent_display_name  = "Access acctest-APP-%[1]d"
+      value                      = "user_impersonation"
+    }
+
+    oauth2_permission_scope {
+      admin_consent_description  = "Administer the application"
+      admin_consent_display_name = "Administer"
+      enabled                    = true
+      id                         = "%[3]s"
+      type                       = "Admin"
+      value                      = "administer"
+    }
+  }
+}
+`, data.RandomInteger, scopeIDs[0], scopeIDs[1])
+}
+
+func (ApplicationResource) oauth2PermissionScope(data acceptance.TestData, scopeID, value string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Read data from acctest-APP-%[1]d"
+      admin_consent_display_name = "Read"
+      enabled                    = true
+      id                         = "%[2]s"
+      type                       = "Admin"
+      value                      = "%[3]s"
     }
   }
+}
+`, data.RandomInteger, scopeID, value)
+}
 
-  single_page_application {
-    redirect_uris = [
-      "https://beta.hashitown-%[1]d.com/",
-    ]
-  }
-
-  tags = [
-    "HideApp",
-    "WindowsAzureActiveDirectoryCustomSingleSignOnApplication",
-    "WindowsAzureActiveDirectoryIntegratedApp",
-    "WindowsAzureActiveDirectoryGalleryApplicationNonPrimaryV1",
-  ]
-
-  web {
-    homepage_url = "https://app.hashitown-%[1]d.com/"
-    logout_url   = "https://app.hashitown-%[1]d.com/logout"
+func (ApplicationResource) oauth2PermissionScopeWithoutId(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
 
-    redirect_uris = [
-      "https://app.hashitown-%[1]d.com/",
-      "https://classic.hashitown-%[1]d.com/",
-      "urn:ietf:wg:oauth:2.0:oob",
-    ]
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
 
-    implicit_grant {
-      access_token_issuance_enabled = true
-      id_token_issuance_enabled     = true
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Read data from acctest-APP-%[1]d"
+      admin_consent_display_name = "Read"
+      enabled                    = true
+      type                       = "Admin"
+      value                      = "%[2]s"
     }
   }
-
-  owners = [azuread_user.test.object_id]
 }
-`, data.RandomInteger, data.RandomPassword, data.UUID(), data.UUID(), data.UUID(), data.UUID())
+`, data.RandomInteger, value)
 }
 
-func (ApplicationResource) completeFromTemplate(data acceptance.TestData) string {
+func (ApplicationResource) oauth2PermissionScopeEnabled(data acceptance.TestData, scopeID string, enabled bool) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
-data "azuread_domains" "test" {
-  only_initial = true
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Read data from acctest-APP-%[1]d"
+      admin_consent_display_name = "Read"
+      enabled                    = %[3]t
+      id                         = "%[2]s"
+      type                       = "Admin"
+      value                      = "read"
+    }
+  }
+}
+`, data.RandomInteger, scopeID, enabled)
 }
 
-resource "azuread_user" "test" {
-  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
-  display_name        = "acctestUser-%[1]d"
-  password            = "%[2]s"
+func (ApplicationResource) oauth2PermissionScopeConsentedTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {
+  prevent_enabled_app_role_and_scope_removal = true
 }
 
-resource "azuread_application" "known1" {
-  display_name = "acctest-APP-known1-%[1]d"
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
 }
 
-resource "azuread_application" "known2" {
-  display_name = "acctest-APP-known2-%[1]d"
+resource "azuread_application" "consumer" {
+  display_name = "acctest-APP-consumer-%[1]d"
 }
 
-resource "azuread_application" "test" {
-  display_name            = "acctest-APP-complete-%[1]d"
-  template_id             = "%[3]s"
-  group_membership_claims = ["All"]
-  sign_in_audience        = "AzureADandPersonalMicrosoftAccount"
+resource "azuread_service_principal" "consumer" {
+  application_id = azuread_application.consumer.application_id
+}
 
-  identifier_uris = [
-    "api://hashicorptestapp-%[1]d",
-    "api://acctest-APP-complete-%[1]d",
-  ]
+resource "azuread_service_principal_delegated_permission_grant" "test" {
+  service_principal_object_id          = azuread_service_principal.consumer.object_id
+  resource_service_principal_object_id = azuread_service_principal.test.object_id
+  claim_values                         = ["read"]
+}
+`, data.RandomInteger)
+}
 
-  device_only_auth_enabled       = true
-  fallback_public_client_enabled = true
-  oauth2_post_response_required  = true
+func (r ApplicationResource) oauth2PermissionScopeConsented(data acceptance.TestData, scopeID string, enabled bool) string {
+	return fmt.Sprintf(`
+%[1]s
 
-  marketing_url         = "https://templatetown-%[1]d.com/"
-  privacy_statement_url = "https://templatetown-%[1]d.com/privacy"
-  support_url           = "https://support.templatetown-%[1]d.com/"
-  terms_of_service_url  = "https://templatetown-%[1]d.com/terms"
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[2]d"
 
   api {
-    mapped_claims_enabled          = true
-    requested_access_token_version = 2
-
-    known_client_applications = [
-      azuread_application.known1.application_id,
-      azuread_application.known2.application_id,
-    ]
-
     oauth2_permission_scope {
-      admin_consent_description  = "Administer the application"
-      admin_consent_display_name = "Administer"
-      enabled                    = true
-      id                         = "%[5]s"
+      admin_consent_description  = "Read data from acctest-APP-%[2]d"
+      admin_consent_display_name = "Read"
+      enabled                    = %[4]t
+      id                         = "%[3]s"
       type                       = "Admin"
-      value                      = "administer"
+      value                      = "read"
     }
+  }
+}
+`, r.oauth2PermissionScopeConsentedTemplate(data), data.RandomInteger, scopeID, enabled)
+}
+
+func (r ApplicationResource) oauth2PermissionScopeConsentedRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
 
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[2]d"
+}
+`, r.oauth2PermissionScopeConsentedTemplate(data), data.RandomInteger)
+}
+
+func (ApplicationResource) oauth2PermissionScopesUpdate(data acceptance.TestData, scopeIDs []string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
     oauth2_permission_scope {
-      admin_consent_description  = "Allow the application to access acctest-APP-%[1]d on behalf of the signed-in user."
+      admin_consent_description  = "Allow the application to access on behalf blah... this changed"
       admin_consent_display_name = "Access acctest-APP-%[1]d"
       enabled                    = true
-      id                         = "%[6]s"
+      id                         = "%[2]s"
       type                       = "User"
       user_consent_description   = "Allow the application to access acctest-APP-%[1]d on your behalf."
       user_consent_display_name  = "Access acctest-APP-%[1]d"
       value                      = "user_impersonation"
     }
-  }
-
-  app_role {
-    allowed_member_types = ["User"]
-    description          = "msiam_access"
-    display_name         = "msiam_access"
-    enabled              = true
-    id                   = "%[4]s"
-  }
-
-  optional_claims {
-    access_token {
-      name = "myclaim"
-    }
-
-    access_token {
-      name = "otherclaim"
-    }
 
-    id_token {
-      name                  = "userclaim"
-      source                = "user"
-      essential             = true
-      additional_properties = ["emit_as_roles"]
+    oauth2_permission_scope {
+      admin_consent_description  = "Administer the application"
+      admin_consent_display_name = "Administer"
+      enabled                    = true
+      id                         = "%[3]s"
+      type                       = "Admin"
+      value                      = "administer"
     }
 
-    saml2_token {
-      name = "samlexample"
+    oauth2_permission_scope {
+      admin_consent_description  = "Audit the application"
+      admin_consent_display_name = "Audit"
+      enabled                    = true
+      id                         = "%[4]s"
+      type                       = "Admin"
+      value                      = "audit"
     }
   }
+}
+`, data.RandomInteger, scopeIDs[0], scopeIDs[1], scopeIDs[2])
+}
 
-  required_resource_access {
-    resource_app_id = "00000003-0000-0000-c000-000000000000"
+func (ApplicationResource) preventDuplicateNamesPass(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
 
-    resource_access {
-      id   = "7ab1d382-f21e-4acd-a863-ba3e13f7da61"
-      type = "Role"
-    }
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-%[1]d"
+  prevent_duplicate_names = true
+}
+`, data.RandomInteger)
+}
 
-    resource_access {
-      id   = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
-      type = "Scope"
-    }
+func (r ApplicationResource) preventDuplicateNamesFail(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
 
-    resource_access {
-      id   = "06da0dbc-49e2-44d2-8312-53f166ab848a"
-      type = "Scope"
-    }
-  }
+resource "azuread_application" "duplicate" {
+  display_name            = azuread_application.test.display_name
+  prevent_duplicate_names = true
+}
+`, r.basic(data))
+}
 
-  required_resource_access {
-    resource_app_id = "00000002-0000-0000-c000-000000000000"
+func (ApplicationResource) permanentlyDelete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
 
-    resource_access {
-      id   = "311a71cc-e848-46a1-bdf8-97ff7156d8e6"
-      type = "Scope"
-    }
-  }
+resource "azuread_application" "test" {
+  display_name       = "acctest-APP-%[1]d"
+  identifier_uris    = ["api://acctest-APP-%[1]d"]
+  permanently_delete = true
+}
+`, data.RandomInteger)
+}
 
-  single_page_application {
-    redirect_uris = [
-      "https://beta.templatetown-%[1]d.com/",
-    ]
-  }
+func (ApplicationResource) disableOnDestroy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
 
-  tags = [
-    "WindowsAzureActiveDirectoryCustomSingleSignOnApplication",
-    "WindowsAzureActiveDirectoryIntegratedApp",
-    "WindowsAzureActiveDirectoryGalleryApplicationNonPrimaryV1",
-  ]
+resource "azuread_application" "test" {
+  display_name       = "acctest-APP-%[1]d"
+  identifier_uris    = ["api://acctest-APP-%[1]d"]
+  disable_on_destroy = true
+}
+`, data.RandomInteger)
+}
 
-  web {
-    homepage_url = "https://app.templatetown-%[1]d.com/"
-    logout_url   = "https://app.templatetown-%[1]d.com/logout"
+func (ApplicationResource) restoreIfDeleted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
 
-    redirect_uris = [
-      "https://app.templatetown-%[1]d.com/",
-      "https://classic.templatetown-%[1]d.com/",
-    ]
+resource "azuread_application" "test" {
+  display_name       = "acctest-APP-%[1]d"
+  identifier_uris    = ["api://acctest-APP-restore-%[1]d"]
+  restore_if_deleted = true
+}
+`, data.RandomInteger)
+}
 
-    implicit_grant {
-      access_token_issuance_enabled = true
-      id_token_issuance_enabled     = true
-    }
-  }
+func (ApplicationResource) identifierUris(data acceptance.TestData, reversed bool) string {
+	uris := fmt.Sprintf(`"api://acctest-APP-%[1]d", "https://acctest-app-%[1]d.example.com/api"`, data.RandomInteger)
+	if reversed {
+		uris = fmt.Sprintf(`"https://acctest-app-%[1]d.example.com/api", "api://acctest-APP-%[1]d"`, data.RandomInteger)
+	}
 
-  owners = [azuread_user.test.object_id]
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  identifier_uris  = [%[2]s]
+  sign_in_audience = "AzureADMyOrg"
 }
-`, data.RandomInteger, data.RandomPassword, testApplicationTemplateId, testApplicationTemplateAppRoleId, data.UUID(), data.UUID())
+`, data.RandomInteger, uris)
 }
 
-func (ApplicationResource) appRole(data acceptance.TestData, roleIDs []string) string {
+func (ApplicationResource) signInAudience(data acceptance.TestData, signInAudience, identifierUri string) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
+data "azuread_client_config" "test" {}
+
 resource "azuread_application" "test" {
-  display_name = "acctest-APP-%[1]d"
+  display_name     = "acctest-APP-%[1]d"
+  identifier_uris  = ["%[3]s"]
+  owners           = [data.azuread_client_config.test.object_id]
+  sign_in_audience = "%[2]s"
+}
 
-  app_role {
-    allowed_member_types = ["User", "Application"]
-    description          = "Admins can manage roles and perform all task actions"
-    display_name         = "Admin"
-    enabled              = true
-    id                   = "%[2]s"
-    value                = "admin"
-  }
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application.test.object_id
 }
-`, data.RandomInteger, roleIDs[0])
+`, data.RandomInteger, signInAudience, identifierUri)
 }
 
-func (ApplicationResource) appRoleNoValue(data acceptance.TestData, roleIDs []string) string {
+func (ApplicationResource) validateRequiredResourceAccess(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
 resource "azuread_application" "test" {
-  display_name = "acctest-APP-%[1]d"
+  display_name                      = "acctest-APP-%[1]d"
+  validate_required_resource_access = true
 
-  app_role {
-    allowed_member_types = ["User"]
-    description          = "Admins can manage roles and perform all task actions"
-    display_name         = "Admin"
-    id                   = "%[2]s"
-    enabled              = true
+  required_resource_access {
+    resource_app_id = "00000003-0000-0000-c000-000000000000"
+
+    resource_access {
+      id   = "7ab1d382-f21e-4acd-a863-ba3e13f7da61"
+      type = "Role"
+    }
+
+    resource_access {
+      id   = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
+      type = "Scope"
+    }
   }
 }
-`, data.RandomInteger, roleIDs[0])
+`, data.RandomInteger)
 }
 
-func (ApplicationResource) appRolesUpdate(data acceptance.TestData, roleIDs []string) string {
+func (ApplicationResource) samlMetadataUrl(data acceptance.TestData, path string) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
 resource "azuread_application" "test" {
-  display_name = "acctestApp-%[1]d"
-
-  app_role {
-    allowed_member_types = ["User"]
-    description          = "Admins can manage roles and perform all task actions"
-    display_name         = "Admin"
-    enabled              = true
-    id                   = "%[2]s"
-    value                = "admin"
-  }
-
-  app_role {
-    allowed_member_types = ["User"]
-    description          = "ReadOnly roles have limited query access"
-    display_name         = "ReadOnly"
-    enabled              = true
-    id                   = "%[3]s"
-    value                = "user"
-  }
+  display_name      = "acctest-APP-%[1]d"
+  sign_in_audience  = "AzureADMyOrg"
+  saml_metadata_url = "https://acctest-app-%[1]d.example.com/saml/%[2]s"
 }
-`, data.RandomInteger, roleIDs[0], roleIDs[1])
+`, data.RandomInteger, path)
 }
 
-func (ApplicationResource) oauth2PermissionScopes(data acceptance.TestData, scopeIDs []string) string {
+func (ApplicationResource) optionalClaimsDirectoryExtension(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
+resource "azuread_application" "extension" {
+  display_name = "acctest-APP-extension-%[1]d"
+}
+
 resource "azuread_application" "test" {
   display_name = "acctest-APP-%[1]d"
 
-  api {
-    oauth2_permission_scope {
-      admin_consent_description  = "Allow the application to access acctest-APP-%[1]d on behalf of the signed-in user."
-      admin_consent_display_name = "Access acctest-APP-%[1]d"
-      enabled                    = true
-      id                         = "%[2]s"
-      type                       = "User"
-      user_consent_description   = "Allow the application to access acctest-APP-%[1]d on your behalf."
-      user_consent_display_name  = "Access acctest-APP-%[1]d"
-      value                      = "user_impersonation"
+  optional_claims {
+    access_token {
+      name = "upn"
     }
 
-    oauth2_permission_scope {
-      admin_consent_description  = "Administer the application"
-      admin_consent_display_name = "Administer"
-      enabled                    = true
-      id                         = "%[3]s"
-      type                       = "Admin"
-      value                      = "administer"
+    access_token {
+      name      = "extension_${replace(azuread_application.extension.application_id, "-", "")}_department"
+      source    = "user"
+      essential = true
     }
   }
 }
-`, data.RandomInteger, scopeIDs[0], scopeIDs[1])
+`, data.RandomInteger)
 }
 
-func (ApplicationResource) oauth2PermissionScopesUpdate(data acceptance.TestData, scopeIDs []string) string {
+func (ApplicationResource) platforms(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
 resource "azuread_application" "test" {
   display_name = "acctest-APP-%[1]d"
 
-  api {
-    oauth2_permission_scope {
-      admin_consent_description  = "Allow the application to access on behalf blah... this changed"
-      admin_consent_display_name = "Access acctest-APP-%[1]d"
-      enabled                    = true
-      id                         = "%[2]s"
-      type                       = "User"
-      user_consent_description   = "Allow the application to access acctest-APP-%[1]d on your behalf."
-      user_consent_display_name  = "Access acctest-APP-%[1]d"
-      value                      = "user_impersonation"
-    }
+  public_client {
+    redirect_uris = ["https://login.microsoftonline.com/common/oauth2/nativeclient"]
+  }
 
-    oauth2_permission_scope {
-      admin_consent_description  = "Administer the application"
-      admin_consent_display_name = "Administer"
-      enabled                    = true
-      id                         = "%[3]s"
-      type                       = "Admin"
-      value                      = "administer"
+  single_page_application {
+    redirect_uris = ["https://acctest-app-%[1]d.example.com/spa/"]
+  }
+
+  web {
+    homepage_url  = "https://acctest-app-%[1]d.example.com"
+    logout_url    = "https://acctest-app-%[1]d.example.com/logout"
+    redirect_uris = ["https://acctest-app-%[1]d.example.com/auth/"]
+
+    implicit_grant {
+      access_token_issuance_enabled = true
+      id_token_issuance_enabled     = true
     }
+  }
+}
+`, data.RandomInteger)
+}
 
-    oauth2_permission_scope {
-      admin_consent_description  = "Audit the application"
-      admin_consent_display_name = "Audit"
-      enabled                    = true
-      id                         = "%[4]s"
-      type                       = "Admin"
-      value                      = "audit"
+func (ApplicationResource) implicitGrant(data acceptance.TestData, enabled bool) string {
+	implicitGrant := ""
+	if enabled {
+		implicitGrant = `
+    implicit_grant {
+      access_token_issuance_enabled = true
+      id_token_issuance_enabled     = true
     }
+`
+	}
+
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  web {
+    redirect_uris = ["https://acctest-app-%[1]d.example.com/auth/"]
+%[2]s
   }
 }
-`, data.RandomInteger, scopeIDs[0], scopeIDs[1], scopeIDs[2])
+`, data.RandomInteger, implicitGrant)
 }
 
-func (ApplicationResource) preventDuplicateNamesPass(data acceptance.TestData) string {
+func (ApplicationResource) logoutUrl(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
 resource "azuread_application" "test" {
-  display_name            = "acctest-APP-%[1]d"
-  prevent_duplicate_names = true
+  display_name     = "acctest-APP-%[1]d"
+  identifier_uris  = ["api://acctest-APP-%[1]d"]
+  sign_in_audience = "AzureADMyOrg"
+
+  web {
+    logout_url    = "https://acctest-app-%[1]d.example.com/saml/logout"
+    redirect_uris = ["https://acctest-app-%[1]d.example.com/saml/acs"]
+  }
 }
 `, data.RandomInteger)
 }
 
-func (r ApplicationResource) preventDuplicateNamesFail(data acceptance.TestData) string {
+func (ApplicationResource) webUrls(data acceptance.TestData, homepageUrl, logoutUrl string) string {
 	return fmt.Sprintf(`
-%[1]s
+provider "azuread" {}
 
-resource "azuread_application" "duplicate" {
-  display_name            = azuread_application.test.display_name
-  prevent_duplicate_names = true
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  web {
+    homepage_url  = "%[2]s"
+    logout_url    = "%[3]s"
+    redirect_uris = ["https://acctest-app-%[1]d.example.com/auth/"]
+  }
 }
-`, r.basic(data))
+`, data.RandomInteger, homepageUrl, logoutUrl)
 }
 
 func (ApplicationResource) related(data acceptance.TestData, uuids []string) string {
@@ -1298,6 +2716,64 @@ resource "azuread_application" "test" {
 `, data.RandomInteger, data.UUID(), data.UUID())
 }
 
+func (ApplicationResource) oauth2PermissionScopeConsentFields(data acceptance.TestData, scopeType string, userConsent bool) string {
+	userConsentFields := ""
+	if userConsent {
+		userConsentFields = `
+      user_consent_description   = "Administer the application"
+      user_consent_display_name  = "Administer"`
+	}
+
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Administer the application"
+      admin_consent_display_name = "Administer"
+      enabled                    = true
+      id                         = "%[2]s"
+      type                       = "%[3]s"%[4]s
+      value                      = "administer"
+    }
+  }
+}
+`, data.RandomInteger, data.UUID(), scopeType, userConsentFields)
+}
+
+func (ApplicationResource) redirectUris(data acceptance.TestData, publicClientCount, singlePageApplicationCount, webCount int) string {
+	uris := func(prefix string, count int) string {
+		result := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			result = append(result, fmt.Sprintf("%q", fmt.Sprintf("%s/%d", prefix, i)))
+		}
+		return strings.Join(result, ", ")
+	}
+
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  public_client {
+    redirect_uris = [%[2]s]
+  }
+
+  single_page_application {
+    redirect_uris = [%[3]s]
+  }
+
+  web {
+    redirect_uris = [%[4]s]
+  }
+}
+`, data.RandomInteger, uris("https://public.hashitown.com", publicClientCount), uris("https://spa.hashitown.com", singlePageApplicationCount), uris("https://web.hashitown.com", webCount))
+}
+
 func (ApplicationResource) templateThreeUsers(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -1351,6 +2827,35 @@ resource "azuread_application" "test" {
 `, r.templateThreeUsers(data), data.RandomInteger)
 }
 
+func (r ApplicationResource) singleOwnerIgnoreUnmanaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-%[2]d"
+  ignore_unmanaged_owners = true
+  owners = [
+    azuread_user.testA.object_id,
+  ]
+}
+`, r.templateThreeUsers(data), data.RandomInteger)
+}
+
+func (r ApplicationResource) twoOwnersIgnoreUnmanaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-%[2]d"
+  ignore_unmanaged_owners = true
+  owners = [
+    azuread_user.testA.object_id,
+    azuread_user.testC.object_id,
+  ]
+}
+`, r.templateThreeUsers(data), data.RandomInteger)
+}
+
 func (r ApplicationResource) threeOwners(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -1463,3 +2968,78 @@ resource "azuread_application" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (ApplicationResource) verifiedPublisher(data acceptance.TestData, verifiedPublisherId string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name          = "acctest-APP-%[1]d"
+  verified_publisher_id = "%[2]s"
+}
+`, data.RandomInteger, verifiedPublisherId)
+}
+
+func (ApplicationResource) concurrentSubResources(data acceptance.TestData, roleId, scopeId string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "authorized" {
+  display_name = "acctest-APP-authorized-%[1]d"
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Access the application"
+      admin_consent_display_name = "Access"
+      enabled                    = true
+      id                         = "%[3]s"
+      type                       = "User"
+      user_consent_description   = "Access the application"
+      user_consent_display_name  = "Access"
+      value                      = "user_impersonation"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [app_role, identifier_uris]
+  }
+}
+
+resource "azuread_application_app_role" "test" {
+  application_object_id = azuread_application.test.object_id
+  role_id               = "%[2]s"
+  allowed_member_types  = ["User"]
+  description           = "Admins can manage roles and perform all task actions"
+  display_name          = "Admin"
+  value                 = "Admin"
+}
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_object_id = azuread_application.test.object_id
+  display_name          = "hashitown-%[1]d"
+  audiences             = ["api://AzureADTokenExchange"]
+  issuer                = "https://tokens.hashitown.net"
+  subject               = "%[2]s"
+}
+
+resource "azuread_application_identifier_uri" "first" {
+  application_object_id = azuread_application.test.object_id
+  identifier_uri        = "api://acctest-APP-%[1]d"
+}
+
+resource "azuread_application_identifier_uri" "second" {
+  application_object_id = azuread_application.test.object_id
+  identifier_uri        = "api://acctest-APP-%[1]d-second"
+}
+
+resource "azuread_application_pre_authorized" "test" {
+  application_object_id = azuread_application.test.object_id
+  authorized_app_id     = azuread_application.authorized.application_id
+  permission_ids        = ["%[3]s"]
+}
+`, data.RandomInteger, roleId, scopeId)
+}
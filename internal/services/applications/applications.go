@@ -2,19 +2,26 @@ package applications
 
 import (
 	"context"
+	"crypto/sha1"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"reflect"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
 
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	applicationsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
@@ -79,7 +86,7 @@ func applicationDisableAppRoles(ctx context.Context, client *msgraph.Application
 			return fmt.Errorf("new role provided with nil or empty ID")
 		}
 		for i, existing := range existingRoles {
-			if existing.ID != nil && *existing.ID == *new.ID {
+			if existing.ID != nil && strings.EqualFold(*existing.ID, *new.ID) {
 				if existing.IsEnabled != nil && *existing.IsEnabled && applicationAppRoleChanged(existing, new) {
 					*existingRoles[i].IsEnabled = false
 					disable = true
@@ -89,17 +96,17 @@ func applicationDisableAppRoles(ctx context.Context, client *msgraph.Application
 		}
 	}
 
-	// Identify any roles to be removed
+	// Identify any roles to be removed, which must be disabled before they can be removed
 	for i, existing := range existingRoles {
 		found := false
 		for _, new := range *newRoles {
-			if existing.ID != nil && *new.ID == *existing.ID {
+			if existing.ID != nil && strings.EqualFold(*new.ID, *existing.ID) {
 				found = true
 				break
 			}
 		}
-		if !found {
-			*existingRoles[i].IsEnabled = false
+		if !found && (existing.IsEnabled == nil || *existing.IsEnabled) {
+			existingRoles[i].IsEnabled = utils.Bool(false)
 			disable = true
 		}
 	}
@@ -194,7 +201,7 @@ func applicationDisableOauth2PermissionScopes(ctx context.Context, client *msgra
 			return fmt.Errorf("new scope provided with nil or empty ID")
 		}
 		for i, existing := range existingScopes {
-			if existing.ID != nil && *existing.ID == *new.ID {
+			if existing.ID != nil && strings.EqualFold(*existing.ID, *new.ID) {
 				if existing.IsEnabled != nil && *existing.IsEnabled && !reflect.DeepEqual(existing, new) {
 					*existingScopes[i].IsEnabled = false
 					disable = true
@@ -204,17 +211,17 @@ func applicationDisableOauth2PermissionScopes(ctx context.Context, client *msgra
 		}
 	}
 
-	// Identify any scopes to be removed
+	// Identify any scopes to be removed, which must be disabled before they can be removed
 	for i, existing := range existingScopes {
 		found := false
 		for _, new := range *newScopes {
-			if existing.ID != nil && *new.ID == *existing.ID {
+			if existing.ID != nil && strings.EqualFold(*new.ID, *existing.ID) {
 				found = true
 				break
 			}
 		}
-		if !found {
-			*existingScopes[i].IsEnabled = false
+		if !found && (existing.IsEnabled == nil || *existing.IsEnabled) {
+			existingScopes[i].IsEnabled = utils.Bool(false)
 			disable = true
 		}
 	}
@@ -276,6 +283,204 @@ func applicationDisableOauth2PermissionScopes(ctx context.Context, client *msgra
 	return nil
 }
 
+// applicationIsPermissionNotDisabledError returns true when the API rejected an update because it would remove or change an
+// app role or permission scope that is not yet disabled.
+func applicationIsPermissionNotDisabledError(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), "cannot be deleted or updated unless disabled first")
+}
+
+// applicationValidatePublisherDomain checks that the specified publisher domain is present in the tenant's list of
+// domains and has been verified, since the API otherwise rejects the domain with a less helpful error.
+func applicationValidatePublisherDomain(ctx context.Context, client *msgraph.DomainsClient, publisherDomain string) error {
+	domains, _, err := client.List(ctx, odata.Query{})
+	if err != nil {
+		return fmt.Errorf("retrieving domains for tenant: %+v", err)
+	}
+	if domains == nil {
+		return fmt.Errorf("retrieving domains for tenant: API returned a nil result")
+	}
+
+	for _, domain := range *domains {
+		if domain.ID == nil || !strings.EqualFold(*domain.ID, publisherDomain) {
+			continue
+		}
+		if domain.IsVerified == nil || !*domain.IsVerified {
+			return fmt.Errorf("the domain %q has not been verified for this tenant, only verified domains can be used as a publisher domain", publisherDomain)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("the domain %q was not found in this tenant, the publisher domain must be a verified domain belonging to the tenant", publisherDomain)
+}
+
+// applicationValidateRequiredResourceAccess resolves the service principal for each resource application in the
+// provided required_resource_access blocks, and checks that every requested role and scope is published by it. This is
+// best-effort, and any problems are returned as warnings so that they do not prevent the application from being saved.
+func applicationValidateRequiredResourceAccess(ctx context.Context, client *msgraph.ServicePrincipalsClient, in []interface{}) (diags diag.Diagnostics) {
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		requiredResourceAccess := raw.(map[string]interface{})
+		resourceAppId := requiredResourceAccess["resource_app_id"].(string)
+
+		query := odata.Query{
+			Filter: fmt.Sprintf("appId eq '%s'", utils.EscapeSingleQuote(resourceAppId)),
+			Select: []string{"appRoles", "id", "publishedPermissionScopes"},
+		}
+		result, _, err := client.List(ctx, query)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Could not validate required resource access for resource application %q", resourceAppId),
+				Detail:   err.Error(),
+			})
+			continue
+		}
+		if result == nil || len(*result) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("No service principal was found for resource application %q", resourceAppId),
+				Detail:   "Check that `resource_app_id` is correct, and that the resource application has a service principal in this tenant, otherwise consent will fail.",
+			})
+			continue
+		}
+		servicePrincipal := (*result)[0]
+
+		roleIds := make(map[string]bool)
+		if servicePrincipal.AppRoles != nil {
+			for _, role := range *servicePrincipal.AppRoles {
+				if role.ID != nil {
+					roleIds[strings.ToLower(*role.ID)] = true
+				}
+			}
+		}
+		scopeIds := make(map[string]bool)
+		if servicePrincipal.PublishedPermissionScopes != nil {
+			for _, scope := range *servicePrincipal.PublishedPermissionScopes {
+				if scope.ID != nil {
+					scopeIds[strings.ToLower(*scope.ID)] = true
+				}
+			}
+		}
+
+		for _, accessRaw := range requiredResourceAccess["resource_access"].([]interface{}) {
+			if accessRaw == nil {
+				continue
+			}
+			resourceAccess := accessRaw.(map[string]interface{})
+			id := resourceAccess["id"].(string)
+			accessType := resourceAccess["type"].(string)
+
+			if (accessType == msgraph.ResourceAccessTypeRole && !roleIds[strings.ToLower(id)]) ||
+				(accessType == msgraph.ResourceAccessTypeScope && !scopeIds[strings.ToLower(id)]) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("%s %q was not found for resource application %q", accessType, id, resourceAppId),
+					Detail:   fmt.Sprintf("The service principal for the resource application does not publish a %s with this ID, so consent will fail. Check the `id` and `type` in the `resource_access` block.", strings.ToLower(accessType)),
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// applicationUpdateAfterDisabling updates an application following the disabling of any app roles or permission scopes
+// that are being changed or removed. Disabled roles and scopes are not always consistently reflected by the API straight
+// away, so the update is retried for as long as the API reports that a role or scope must first be disabled.
+func applicationUpdateAfterDisabling(ctx context.Context, client *msgraph.ApplicationsClient, properties msgraph.Application) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context has no deadline")
+	}
+
+	return resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		if _, err := client.Update(ctx, properties); err != nil {
+			if applicationIsPermissionNotDisabledError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// applicationSignInAudienceRank orders the supported sign-in audiences from narrowest to broadest, so that it can be
+// determined whether a change of audience imposes stricter requirements on the application.
+func applicationSignInAudienceRank(signInAudience string) int {
+	switch signInAudience {
+	case msgraph.SignInAudienceAzureADMyOrg:
+		return 0
+	case msgraph.SignInAudienceAzureADMultipleOrgs:
+		return 1
+	}
+	return 2
+}
+
+// applicationMigrateSignInAudience changes the sign-in audience of an existing application in place, so that its owners,
+// credentials and service principal are retained. The API validates the identifier URIs and requested access token
+// version against the sign-in audience, so when broadening the audience these are updated first (retaining any existing
+// permission scopes), and when narrowing the audience it is changed first. Any remaining properties are updated afterwards.
+func applicationMigrateSignInAudience(ctx context.Context, client *msgraph.ApplicationsClient, d *schema.ResourceData) diag.Diagnostics {
+	oldAudienceRaw, newAudienceRaw := d.GetChange("sign_in_audience")
+	oldAudience, newAudience := oldAudienceRaw.(string), newAudienceRaw.(string)
+
+	if applicationSignInAudienceRank(newAudience) > applicationSignInAudienceRank(oldAudience) {
+		oldApi, _ := d.GetChange("api")
+		api := expandApplicationApi(oldApi.([]interface{}))
+		newApi := expandApplicationApi(d.Get("api").([]interface{}))
+		if *newApi.RequestedAccessTokenVersion > *api.RequestedAccessTokenVersion {
+			api.RequestedAccessTokenVersion = newApi.RequestedAccessTokenVersion
+		}
+
+		properties := msgraph.Application{
+			DirectoryObject: msgraph.DirectoryObject{
+				ID: utils.String(d.Id()),
+			},
+			Api:            api,
+			IdentifierUris: tf.ExpandStringSlicePtr(d.Get("identifier_uris").(*schema.Set).List()),
+		}
+		if _, err := client.Update(ctx, properties); err != nil {
+			diags := tf.ODataErrorDiagPathF(err, "identifier_uris", "Could not update identifier URIs and access token version for application with object ID %q prior to changing sign_in_audience to %q", d.Id(), newAudience)
+			diags[0].Detail = fmt.Sprintf("%s\n\n%s", applicationSignInAudienceMigrationSteps(oldAudience, newAudience), diags[0].Detail)
+			return diags
+		}
+	}
+
+	properties := msgraph.Application{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: utils.String(d.Id()),
+		},
+		SignInAudience: utils.String(newAudience),
+	}
+	if _, err := client.Update(ctx, properties); err != nil {
+		diags := tf.ODataErrorDiagPathF(err, "sign_in_audience", "Could not change sign_in_audience from %q to %q for application with object ID %q", oldAudience, newAudience, d.Id())
+		diags[0].Detail = fmt.Sprintf("%s\n\n%s", applicationSignInAudienceMigrationSteps(oldAudience, newAudience), diags[0].Detail)
+		return diags
+	}
+
+	return nil
+}
+
+// applicationSignInAudienceMigrationSteps describes how to change the sign-in audience of an application manually, for
+// when the API rejects an in-place migration.
+func applicationSignInAudienceMigrationSteps(oldAudience, newAudience string) string {
+	steps := []string{
+		"To change the sign-in audience manually:",
+		"1. Ensure that every value in `identifier_uris` uses the `api://` scheme (e.g. `api://{application_id}`), or an HTTPS URI on a domain that is verified in this tenant.",
+	}
+	if newAudience == msgraph.SignInAudienceAzureADandPersonalMicrosoftAccount || newAudience == msgraph.SignInAudiencePersonalMicrosoftAccount {
+		steps = append(steps,
+			"2. Set `requested_access_token_version` to `2` in the `api` block, and remove any URN-scheme identifier URIs.",
+			"3. Ensure that any `oauth2_permission_scope` values are 40 characters or less, and that redirect URIs do not use wildcard hosts.",
+		)
+	}
+	steps = append(steps, fmt.Sprintf("%d. Apply these changes whilst retaining `sign_in_audience = %q`, then change `sign_in_audience` to %q and apply again.", len(steps), oldAudience, newAudience))
+
+	return strings.Join(steps, "\n")
+}
+
 func applicationFindByName(ctx context.Context, client *msgraph.ApplicationsClient, displayName string) (*[]msgraph.Application, error) {
 	query := odata.Query{
 		Filter: fmt.Sprintf("displayName eq '%s'", displayName),
@@ -297,6 +502,166 @@ func applicationFindByName(ctx context.Context, client *msgraph.ApplicationsClie
 	return &result, nil
 }
 
+// applicationFindDeleted returns the soft-deleted application having any of the specified identifier URIs or, when no
+// identifier URIs are specified, having the specified display name. Returns nil if no deleted application was found,
+// or an error if more than one deleted application matches.
+func applicationFindDeleted(ctx context.Context, client *msgraph.ApplicationsClient, displayName string, identifierUris []string) (*msgraph.Application, error) {
+	apps, _, err := client.ListDeleted(ctx, odata.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list deleted Applications: %+v", err)
+	}
+
+	result := make([]msgraph.Application, 0)
+	if apps != nil {
+		for _, app := range *apps {
+			if app.ID == nil {
+				continue
+			}
+			if len(identifierUris) > 0 {
+				if app.IdentifierUris != nil && len(utils.Intersection(*app.IdentifierUris, identifierUris)) > 0 {
+					result = append(result, app)
+				}
+			} else if app.DisplayName != nil && *app.DisplayName == displayName {
+				result = append(result, app)
+			}
+		}
+	}
+
+	switch len(result) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &result[0], nil
+	default:
+		return nil, fmt.Errorf("found %d deleted applications matching the configured identifier URIs or display name", len(result))
+	}
+}
+
+// applicationFindServicePrincipal returns the service principal in the current tenant for the specified application ID
+// (client ID), or nil if one does not exist
+func applicationFindServicePrincipal(ctx context.Context, client *msgraph.ServicePrincipalsClient, appId string) (*msgraph.ServicePrincipal, error) {
+	query := odata.Query{
+		Filter: fmt.Sprintf("appId eq '%s'", appId),
+	}
+	servicePrincipals, _, err := client.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list Service Principals with filter %q: %+v", query.Filter, err)
+	}
+
+	if servicePrincipals != nil {
+		for _, servicePrincipal := range *servicePrincipals {
+			if servicePrincipal.AppId != nil && *servicePrincipal.AppId == appId {
+				return &servicePrincipal, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// applicationDisableOnDestroy removes the identifier URIs from an application and disables sign-in for its service
+// principal, so that the application can be retained without remaining usable. Where the application has no service
+// principal in the tenant, a disabled one is created to prevent one being provisioned on first use.
+func applicationDisableOnDestroy(ctx context.Context, client *msgraph.ApplicationsClient, servicePrincipalsClient *msgraph.ServicePrincipalsClient, objectId, appId string) error {
+	properties := msgraph.Application{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: utils.String(objectId),
+		},
+		IdentifierUris: &[]string{},
+	}
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("removing identifier URIs: %+v", err)
+	}
+
+	servicePrincipal, err := applicationFindServicePrincipal(ctx, servicePrincipalsClient, appId)
+	if err != nil {
+		return err
+	}
+
+	if servicePrincipal == nil {
+		log.Printf("[INFO] Creating disabled service principal for application with object ID %q", objectId)
+		if _, _, err := servicePrincipalsClient.Create(ctx, msgraph.ServicePrincipal{
+			AccountEnabled: utils.Bool(false),
+			AppId:          utils.String(appId),
+		}); err != nil {
+			return fmt.Errorf("creating disabled service principal: %+v", err)
+		}
+		return nil
+	}
+
+	if servicePrincipal.ID == nil {
+		return fmt.Errorf("API returned service principal with nil object ID")
+	}
+
+	log.Printf("[INFO] Disabling sign-in for service principal with object ID %q", *servicePrincipal.ID)
+	if _, err := servicePrincipalsClient.Update(ctx, msgraph.ServicePrincipal{
+		DirectoryObject: msgraph.DirectoryObject{
+			ID: servicePrincipal.ID,
+		},
+		AccountEnabled: utils.Bool(false),
+	}); err != nil {
+		return fmt.Errorf("disabling sign-in for service principal with object ID %q: %+v", *servicePrincipal.ID, err)
+	}
+
+	return nil
+}
+
+// applicationTemplateCleanup attempts to delete the application and service principal that were created when
+// instantiating an application template, and returns the provided diagnostics along with any errors encountered
+func applicationTemplateCleanup(ctx context.Context, meta interface{}, result *msgraph.ApplicationTemplate, diags diag.Diagnostics) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	servicePrincipalsClient := meta.(*clients.Client).Applications.ServicePrincipalsClient
+
+	if result == nil {
+		return diags
+	}
+
+	if result.ServicePrincipal != nil && result.ServicePrincipal.ID != nil && *result.ServicePrincipal.ID != "" {
+		if status, err := servicePrincipalsClient.Delete(ctx, *result.ServicePrincipal.ID); err != nil && status != http.StatusNotFound {
+			diags = append(diags, tf.ErrorDiagF(err, "Could not clean up service principal with object ID %q after failing to create application from template. This service principal should be deleted manually", *result.ServicePrincipal.ID)...)
+		}
+	}
+
+	if result.Application != nil && result.Application.ID != nil && *result.Application.ID != "" {
+		if status, err := client.Delete(ctx, *result.Application.ID); err != nil && status != http.StatusNotFound {
+			diags = append(diags, tf.ErrorDiagF(err, "Could not clean up application with object ID %q after failing to create application from template. This application should be deleted manually", *result.Application.ID)...)
+		}
+	}
+
+	return diags
+}
+
+// applicationWaitForOwners waits for the owners of an application to reflect the desired owners, since ownership
+// changes are not always immediately visible when reading the application back
+func applicationWaitForOwners(ctx context.Context, client *msgraph.ApplicationsClient, id string, desiredOwners []string) error {
+	return helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		owners, _, err := client.ListOwners(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if owners == nil {
+			return utils.Bool(len(desiredOwners) == 0), nil
+		}
+		return utils.Bool(utils.EqualStringSets(*owners, desiredOwners)), nil
+	})
+}
+
+// applicationWaitForOwnersDelta waits for the owners of an application to include all the desired owners and exclude
+// any removed owners, disregarding any other owners that may be present
+func applicationWaitForOwnersDelta(ctx context.Context, client *msgraph.ApplicationsClient, id string, desiredOwners, removedOwners []string) error {
+	return helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		owners, _, err := client.ListOwners(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		existingOwners := make([]string, 0)
+		if owners != nil {
+			existingOwners = *owners
+		}
+		return utils.Bool(len(utils.Difference(desiredOwners, existingOwners)) == 0 && len(utils.Intersection(existingOwners, removedOwners)) == 0), nil
+	})
+}
+
 func applicationParseLogoImage(encodedImage string) (string, []byte, error) {
 	imageData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedImage))
 	if err != nil {
@@ -309,6 +674,138 @@ func applicationParseLogoImage(encodedImage string) (string, []byte, error) {
 	return contentType, imageData, nil
 }
 
+// applicationOAuth2PermissionScopeIdNamespace is the namespace used to derive stable IDs for permission scopes from
+// their values, when no ID is specified in configuration
+const applicationOAuth2PermissionScopeIdNamespace = "5d8b0e1c-3f4a-4c2e-9b7d-6a1f0e2c8d43"
+
+// applicationDeriveOAuth2PermissionScopeId returns a name-based (version 5) UUID for the provided permission scope value,
+// which is stable across applies so that the scope is not replaced.
+func applicationDeriveOAuth2PermissionScopeId(value string) string {
+	namespace, _ := uuid.ParseUUID(applicationOAuth2PermissionScopeIdNamespace)
+
+	h := sha1.New()
+	h.Write(namespace)
+	h.Write([]byte(value))
+	sum := h.Sum(nil)
+
+	sum[6] = (sum[6] & 0x0f) | 0x50 // version 5
+	sum[8] = (sum[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// applicationRoleScopeId returns the ID of an app role or permission scope block. Where a permission scope does not
+// specify an ID, this is derived from its value. Returns an empty string when neither is known.
+func applicationRoleScopeId(in map[string]interface{}) string {
+	if id, _ := in["id"].(string); id != "" {
+		return id
+	}
+	if value, _ := in["value"].(string); tf.ValueIsNotEmptyOrUnknown(value) {
+		return applicationDeriveOAuth2PermissionScopeId(value)
+	}
+	return ""
+}
+
+// applicationOAuth2PermissionScopeHash computes the set hash for a permission scope using its effective ID, so that
+// a scope whose ID is omitted in configuration matches the same scope read back from the API.
+func applicationOAuth2PermissionScopeHash(v interface{}) int {
+	scope := v.(map[string]interface{})
+
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(applicationRoleScopeId(scope))))
+	for _, k := range []string{"admin_consent_description", "admin_consent_display_name", "enabled", "type", "user_consent_description", "user_consent_display_name", "value"} {
+		buf.WriteString(fmt.Sprintf("%v-", scope[k]))
+	}
+
+	return schema.HashString(buf.String())
+}
+
+// applicationValidateStableIds returns an error when an existing app role or permission scope is assigned a new ID
+// whilst retaining its value, since consumers reference roles and scopes by ID and would silently lose access.
+func applicationValidateStableIds(oldItems, newItems []interface{}, blockName string) error {
+	newIds := make(map[string]bool)
+	for _, raw := range newItems {
+		if raw == nil {
+			continue
+		}
+		if id := applicationRoleScopeId(raw.(map[string]interface{})); tf.ValueIsNotEmptyOrUnknown(id) {
+			newIds[strings.ToLower(id)] = true
+		}
+	}
+
+	for _, oldRaw := range oldItems {
+		if oldRaw == nil {
+			continue
+		}
+		old := oldRaw.(map[string]interface{})
+		oldId, oldValue := applicationRoleScopeId(old), old["value"].(string)
+		if oldId == "" || oldValue == "" || newIds[strings.ToLower(oldId)] {
+			continue
+		}
+
+		for _, newRaw := range newItems {
+			if newRaw == nil {
+				continue
+			}
+			new := newRaw.(map[string]interface{})
+			newId, newValue := applicationRoleScopeId(new), new["value"].(string)
+			if newValue == oldValue && tf.ValueIsNotEmptyOrUnknown(newId) && !strings.EqualFold(newId, oldId) {
+				return fmt.Errorf("the `id` of the existing `%s` with value %q cannot be changed from %q to %q. Consumers reference this by its ID; to replace it, remove it and add a new `%s` with a different value", blockName, oldValue, oldId, newId, blockName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applicationRemovedEnabledRolesScopes returns the values (or IDs, where no value is set) of any enabled app roles or
+// permission scopes in oldItems which are absent from newItems. Consumers which have been granted these would be
+// broken by their removal, so they should first be disabled. Returns nil when any new ID is not yet known.
+func applicationRemovedEnabledRolesScopes(oldItems, newItems []interface{}) []string {
+	newIds := make(map[string]bool)
+	for _, raw := range newItems {
+		if raw == nil {
+			continue
+		}
+		id := applicationRoleScopeId(raw.(map[string]interface{}))
+		if !tf.ValueIsNotEmptyOrUnknown(id) {
+			return nil
+		}
+		newIds[strings.ToLower(id)] = true
+	}
+
+	removed := make([]string, 0)
+	for _, oldRaw := range oldItems {
+		if oldRaw == nil {
+			continue
+		}
+		old := oldRaw.(map[string]interface{})
+		oldId := applicationRoleScopeId(old)
+		if oldId == "" || newIds[strings.ToLower(oldId)] {
+			continue
+		}
+		if enabled, ok := old["enabled"].(bool); !ok || !enabled {
+			continue
+		}
+		if value := old["value"].(string); value != "" {
+			removed = append(removed, value)
+		} else {
+			removed = append(removed, oldId)
+		}
+	}
+
+	return removed
+}
+
+// applicationRemovedRolesScopesWarning returns a warning diagnostic listing enabled roles or scopes that were removed.
+func applicationRemovedRolesScopesWarning(blockName string, removed []string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Enabled `%s` blocks were removed", blockName),
+		Detail:   fmt.Sprintf("The following were removed whilst still enabled: %s. Any consumers which had been granted these can no longer use them. To avoid this, set `enabled = false` and apply before removing them.", strings.Join(removed, ", ")),
+	}
+}
+
 func applicationValidateRolesScopes(appRoles, oauth2Permissions []interface{}) error {
 	var ids, values []string
 
@@ -330,7 +827,7 @@ func applicationValidateRolesScopes(appRoles, oauth2Permissions []interface{}) e
 			continue
 		}
 		scope := scopeRaw.(map[string]interface{})
-		if id := scope["id"].(string); tf.ValueIsNotEmptyOrUnknown(id) {
+		if id := applicationRoleScopeId(scope); tf.ValueIsNotEmptyOrUnknown(id) {
 			ids = append(ids, id)
 		}
 		if val := scope["value"].(string); tf.ValueIsNotEmptyOrUnknown(val) {
@@ -361,6 +858,145 @@ func applicationValidateRolesScopes(appRoles, oauth2Permissions []interface{}) e
 	return nil
 }
 
+// applicationValidateOAuth2PermissionScopeConsent checks that user consent fields are only specified for scopes that users
+// can consent to, and that both fields are specified for such scopes
+func applicationValidateOAuth2PermissionScopeConsent(oauth2Permissions []interface{}) error {
+	for _, scopeRaw := range oauth2Permissions {
+		if scopeRaw == nil {
+			continue
+		}
+		scope := scopeRaw.(map[string]interface{})
+
+		value := scope["value"].(string)
+		userConsentDescription := scope["user_consent_description"].(string)
+		userConsentDisplayName := scope["user_consent_display_name"].(string)
+
+		switch scope["type"].(string) {
+		case msgraph.PermissionScopeTypeAdmin:
+			if userConsentDescription != "" || userConsentDisplayName != "" {
+				return fmt.Errorf("`oauth2_permission_scope` with value %q has type %q, so `user_consent_description` and `user_consent_display_name` must not be specified", value, msgraph.PermissionScopeTypeAdmin)
+			}
+		case msgraph.PermissionScopeTypeUser:
+			if userConsentDescription == "" || userConsentDisplayName == "" {
+				return fmt.Errorf("`oauth2_permission_scope` with value %q has type %q, so both `user_consent_description` and `user_consent_display_name` must be specified", value, msgraph.PermissionScopeTypeUser)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applicationValidateOAuth2PermissionScopeIds checks that an ID can be determined for every permission scope, since
+// an ID can only be derived for scopes having a value.
+func applicationValidateOAuth2PermissionScopeIds(oauth2Permissions []interface{}) error {
+	for _, scopeRaw := range oauth2Permissions {
+		if scopeRaw == nil {
+			continue
+		}
+		scope := scopeRaw.(map[string]interface{})
+
+		if scope["id"].(string) == "" && scope["value"].(string) == "" {
+			return errors.New("`id` must be specified for an `oauth2_permission_scope` that does not have a `value`")
+		}
+	}
+
+	return nil
+}
+
+// applicationOptionalClaimGroupProperties are the additional properties which only modify the predefined `groups` claim
+var applicationOptionalClaimGroupProperties = map[string]bool{
+	"cloud_displayname":                   true,
+	"dns_domain_and_sam_account_name":     true,
+	"emit_as_roles":                       true,
+	"netbios_domain_and_sam_account_name": true,
+	"sam_account_name":                    true,
+}
+
+// applicationValidateOptionalClaims checks that additional properties are not duplicated within a claim, and that
+// group-specific additional properties are only specified for the predefined `groups` claim. Directory extension claims
+// (having a `source`) are not checked, since the API accepts any additional property for them.
+func applicationValidateOptionalClaims(optionalClaims []interface{}) error {
+	for _, optionalClaimsRaw := range optionalClaims {
+		if optionalClaimsRaw == nil {
+			continue
+		}
+		tokens := optionalClaimsRaw.(map[string]interface{})
+
+		for _, tokenType := range []string{"access_token", "id_token", "saml2_token"} {
+			claimsRaw, ok := tokens[tokenType].([]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, claimRaw := range claimsRaw {
+				if claimRaw == nil {
+					continue
+				}
+				claim := claimRaw.(map[string]interface{})
+				name := claim["name"].(string)
+
+				seen := make(map[string]bool)
+				for _, propRaw := range claim["additional_properties"].([]interface{}) {
+					prop, _ := propRaw.(string)
+					if seen[prop] {
+						return fmt.Errorf("duplicate additional property %q specified for the %q claim in `%s`", prop, name, tokenType)
+					}
+					seen[prop] = true
+
+					if claim["source"].(string) == "" && !strings.EqualFold(name, "groups") && applicationOptionalClaimGroupProperties[prop] {
+						return fmt.Errorf("the additional property %q can only be specified for the `groups` claim, but was specified for the %q claim in `%s`", prop, name, tokenType)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// applicationSetVerifiedPublisher sets or, when verifiedPublisherId is empty, unsets the verified publisher for an application
+func applicationSetVerifiedPublisher(ctx context.Context, client *applicationsClient.VerifiedPublisherClient, id, verifiedPublisherId string) diag.Diagnostics {
+	if verifiedPublisherId == "" {
+		if _, err := client.Unset(ctx, id); err != nil {
+			return tf.ErrorDiagPathF(err, "verified_publisher_id", "Could not unset verified publisher for application with object ID %q", id)
+		}
+		return nil
+	}
+
+	if _, err := client.Set(ctx, id, verifiedPublisherId); err != nil {
+		if applicationVerifiedPublisherIneligible(err) {
+			return tf.ErrorDiagPathF(err, "verified_publisher_id", "The MPN ID %q is not eligible to be the verified publisher for application with object ID %q. The Partner Center account must have completed verification, and the publisher domain of the application must match a verified domain for the account", verifiedPublisherId, id)
+		}
+		return tf.ErrorDiagPathF(err, "verified_publisher_id", "Could not set verified publisher for application with object ID %q", id)
+	}
+
+	return nil
+}
+
+// applicationVerifiedPublisherIneligible returns true when the error returned by the setVerifiedPublisher action indicates
+// that the provided MPN ID cannot be used to verify the application, e.g. because the Partner Center account does not
+// exist, has not completed vetting, or is not associated with the publisher domain of the application.
+func applicationVerifiedPublisherIneligible(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, code := range []string{
+		"MPNAccountInvalid",
+		"MPNAccountNotFoundOrNoAccess",
+		"MPNAccountNotVetted",
+		"MPNGlobalAccountNotFound",
+		"MPNIdDoesNotMatchAssociatedMPNAccount",
+		"NoPublisherDomainOnApplication",
+		"NoPublisherIdOnAssociatedMPNAccount",
+		"PublisherDomainMismatch",
+	} {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+	return false
+}
+
 func expandApplicationApi(input []interface{}) (result *msgraph.ApplicationApi) {
 	result = &msgraph.ApplicationApi{
 		AcceptMappedClaims:          utils.Bool(false),
@@ -435,6 +1071,25 @@ func expandApplicationGroupMembershipClaims(in []interface{}) *[]msgraph.GroupMe
 	return &result
 }
 
+// applicationImplicitGrantEnabled returns whether either token issuance flag is enabled in an `implicit_grant` block
+func applicationImplicitGrantEnabled(input []interface{}) bool {
+	if len(input) == 0 || input[0] == nil {
+		return false
+	}
+
+	in := input[0].(map[string]interface{})
+	if v, ok := in["access_token_issuance_enabled"]; ok && v.(bool) {
+		return true
+	}
+	if v, ok := in["id_token_issuance_enabled"]; ok && v.(bool) {
+		return true
+	}
+
+	return false
+}
+
+// expandApplicationImplicitGrantSettings always returns both token issuance flags, so that omitting or removing the
+// `implicit_grant` block disables implicit grant for the application
 func expandApplicationImplicitGrantSettings(input []interface{}) *msgraph.ImplicitGrantSettings {
 	var enableAccessTokenIssuance, enableIdTokenIssuance bool
 
@@ -463,7 +1118,7 @@ func expandApplicationOAuth2PermissionScope(in []interface{}) *[]msgraph.Permiss
 			msgraph.PermissionScope{
 				AdminConsentDescription: utils.String(oauth2Permissions["admin_consent_description"].(string)),
 				AdminConsentDisplayName: utils.String(oauth2Permissions["admin_consent_display_name"].(string)),
-				ID:                      utils.String(oauth2Permissions["id"].(string)),
+				ID:                      utils.String(applicationRoleScopeId(oauth2Permissions)),
 				IsEnabled:               utils.Bool(oauth2Permissions["enabled"].(bool)),
 				Type:                    oauth2Permissions["type"].(string),
 				UserConsentDescription:  utils.String(oauth2Permissions["user_consent_description"].(string)),
@@ -577,6 +1232,13 @@ func expandApplicationResourceAccess(in []interface{}) *[]msgraph.ResourceAccess
 	return &result
 }
 
+func expandApplicationSamlSettings(d *schema.ResourceData) applicationsClient.ApplicationSamlSettings {
+	return applicationsClient.ApplicationSamlSettings{
+		SamlMetadataUrl:      utils.NullableString(d.Get("saml_metadata_url").(string)),
+		TokenEncryptionKeyId: utils.NullableString(d.Get("token_encryption_key_id").(string)),
+	}
+}
+
 func expandApplicationSpa(input []interface{}) (result *msgraph.ApplicationSpa) {
 	result = &msgraph.ApplicationSpa{
 		RedirectUris: &[]string{},
@@ -641,6 +1303,18 @@ func flattenApplicationApi(in *msgraph.ApplicationApi, dataSource bool) []map[st
 	}}
 }
 
+// flattenApplicationDisabledByMicrosoft returns the status indicating why an application was disabled by Microsoft,
+// or an empty string when the application has not been disabled
+func flattenApplicationDisabledByMicrosoft(in interface{}) string {
+	if in == nil {
+		return ""
+	}
+	if v, ok := in.(string); ok {
+		return v
+	}
+	return fmt.Sprintf("%v", in)
+}
+
 func flattenApplicationAppRoleIDs(in *[]msgraph.AppRole) map[string]string {
 	return helpers.ApplicationFlattenAppRoleIDs(in)
 }
@@ -649,6 +1323,10 @@ func flattenApplicationAppRoles(in *[]msgraph.AppRole) []map[string]interface{}
 	return helpers.ApplicationFlattenAppRoles(in)
 }
 
+func flattenApplicationGroupMembershipClaims(in *[]msgraph.GroupMembershipClaim) []string {
+	return helpers.ApplicationFlattenGroupMembershipClaims(in)
+}
+
 func flattenApplicationImplicitGrant(in *msgraph.ImplicitGrantSettings) []map[string]interface{} {
 	if in == nil {
 		return []map[string]interface{}{}
@@ -669,6 +1347,67 @@ func flattenApplicationImplicitGrant(in *msgraph.ImplicitGrantSettings) []map[st
 	}}
 }
 
+func applicationCredentialDate(in *time.Time) string {
+	if in == nil {
+		return ""
+	}
+	return in.Format(time.RFC3339)
+}
+
+// flattenApplicationKeyCredentialsMetadata returns the non-secret properties of the provided certificates
+func flattenApplicationKeyCredentialsMetadata(in *[]msgraph.KeyCredential) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, credential := range *in {
+		result = append(result, map[string]interface{}{
+			"key_id":       credential.KeyId,
+			"display_name": credential.DisplayName,
+			"start_date":   applicationCredentialDate(credential.StartDateTime),
+			"end_date":     applicationCredentialDate(credential.EndDateTime),
+		})
+	}
+
+	return result
+}
+
+// flattenApplicationPasswordCredentialsMetadata returns the non-secret properties of the provided passwords. Passwords
+// created by older versions of the API have their display name stored in the CustomKeyIdentifier property.
+func flattenApplicationPasswordCredentialsMetadata(in *[]msgraph.PasswordCredential) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, credential := range *in {
+		displayName := ""
+		if credential.DisplayName != nil {
+			displayName = *credential.DisplayName
+		} else if credential.CustomKeyIdentifier != nil {
+			if decoded, err := base64.StdEncoding.DecodeString(*credential.CustomKeyIdentifier); err != nil {
+				keyId := ""
+				if credential.KeyId != nil {
+					keyId = *credential.KeyId
+				}
+				log.Printf("[DEBUG] Could not decode CustomKeyIdentifier for password with key ID %q: %+v", keyId, err)
+			} else {
+				displayName = string(decoded)
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"key_id":       credential.KeyId,
+			"display_name": displayName,
+			"start_date":   applicationCredentialDate(credential.StartDateTime),
+			"end_date":     applicationCredentialDate(credential.EndDateTime),
+		})
+	}
+
+	return result
+}
+
 func flattenApplicationOAuth2PermissionScopeIDs(in *[]msgraph.PermissionScope) map[string]string {
 	return helpers.ApplicationFlattenOAuth2PermissionScopeIDs(in)
 }
@@ -690,31 +1429,7 @@ func flattenApplicationOptionalClaims(in *msgraph.OptionalClaims) []map[string]i
 }
 
 func flattenApplicationOptionalClaim(in *[]msgraph.OptionalClaim) []interface{} {
-	if in == nil {
-		return []interface{}{}
-	}
-
-	optionalClaims := make([]interface{}, 0)
-	for _, claim := range *in {
-		optionalClaim := map[string]interface{}{
-			"name":                  claim.Name,
-			"essential":             claim.Essential,
-			"source":                "",
-			"additional_properties": []string{},
-		}
-
-		if claim.Source != nil {
-			optionalClaim["source"] = *claim.Source
-		}
-
-		if claim.AdditionalProperties != nil && len(*claim.AdditionalProperties) > 0 {
-			optionalClaim["additional_properties"] = *claim.AdditionalProperties
-		}
-
-		optionalClaims = append(optionalClaims, optionalClaim)
-	}
-
-	return optionalClaims
+	return helpers.ApplicationFlattenOptionalClaim(in)
 }
 
 func flattenApplicationPublicClient(in *msgraph.PublicClient) []map[string]interface{} {
@@ -797,3 +1512,25 @@ func flattenApplicationWeb(in *msgraph.ApplicationWeb) []map[string]interface{}
 		"implicit_grant": flattenApplicationImplicitGrant(in.ImplicitGrantSettings),
 	}}
 }
+
+func flattenApplicationVerifiedPublisher(in *msgraph.VerifiedPublisher) []map[string]interface{} {
+	if in == nil || in.VerifiedPublisherId == nil || *in.VerifiedPublisherId == "" {
+		return []map[string]interface{}{}
+	}
+
+	addedDateTime := ""
+	if in.AddedDateTime != nil {
+		addedDateTime = in.AddedDateTime.Format(time.RFC3339)
+	}
+
+	displayName := ""
+	if in.DisplayName != nil {
+		displayName = *in.DisplayName
+	}
+
+	return []map[string]interface{}{{
+		"added_date_time":       addedDateTime,
+		"display_name":          displayName,
+		"verified_publisher_id": *in.VerifiedPublisherId,
+	}}
+}
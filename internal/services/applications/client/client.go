@@ -7,24 +7,59 @@ import (
 )
 
 type Client struct {
-	ApplicationsClient         *msgraph.ApplicationsClient
-	ApplicationTemplatesClient *msgraph.ApplicationTemplatesClient
-	DirectoryObjectsClient     *msgraph.DirectoryObjectsClient
+	AppRoleAssignmentsClient        *msgraph.AppRoleAssignmentsClient
+	ApplicationsClient              *msgraph.ApplicationsClient
+	ApplicationSamlSettingsClient   *ApplicationSamlSettingsClient
+	ApplicationTemplatesClient      *msgraph.ApplicationTemplatesClient
+	DelegatedPermissionGrantsClient *msgraph.DelegatedPermissionGrantsClient
+	DirectoryObjectsClient          *msgraph.DirectoryObjectsClient
+	DomainsClient                   *msgraph.DomainsClient
+	ExtensionPropertiesClient       *ExtensionPropertiesClient
+	ServicePrincipalsClient         *msgraph.ServicePrincipalsClient
+	VerifiedPublisherClient         *VerifiedPublisherClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	appRoleAssignmentsClient := msgraph.NewServicePrincipalsAppRoleAssignmentsClient(o.TenantID)
+	o.ConfigureClient(&appRoleAssignmentsClient.BaseClient)
+
 	applicationsClient := msgraph.NewApplicationsClient(o.TenantID)
 	o.ConfigureClient(&applicationsClient.BaseClient)
 
+	applicationSamlSettingsClient := NewApplicationSamlSettingsClient(o.TenantID)
+	o.ConfigureClient(&applicationSamlSettingsClient.BaseClient)
+
 	applicationTemplatesClient := msgraph.NewApplicationTemplatesClient(o.TenantID)
 	o.ConfigureClient(&applicationTemplatesClient.BaseClient)
 
+	delegatedPermissionGrantsClient := msgraph.NewDelegatedPermissionGrantsClient(o.TenantID)
+	o.ConfigureClient(&delegatedPermissionGrantsClient.BaseClient)
+
 	directoryObjectsClient := msgraph.NewDirectoryObjectsClient(o.TenantID)
 	o.ConfigureClient(&directoryObjectsClient.BaseClient)
 
+	domainsClient := msgraph.NewDomainsClient(o.TenantID)
+	o.ConfigureClient(&domainsClient.BaseClient)
+
+	extensionPropertiesClient := NewExtensionPropertiesClient(o.TenantID)
+	o.ConfigureClient(&extensionPropertiesClient.BaseClient)
+
+	servicePrincipalsClient := msgraph.NewServicePrincipalsClient(o.TenantID)
+	o.ConfigureClient(&servicePrincipalsClient.BaseClient)
+
+	verifiedPublisherClient := NewVerifiedPublisherClient(o.TenantID)
+	o.ConfigureClient(&verifiedPublisherClient.BaseClient)
+
 	return &Client{
-		ApplicationsClient:         applicationsClient,
-		ApplicationTemplatesClient: applicationTemplatesClient,
-		DirectoryObjectsClient:     directoryObjectsClient,
+		AppRoleAssignmentsClient:        appRoleAssignmentsClient,
+		ApplicationsClient:              applicationsClient,
+		ApplicationSamlSettingsClient:   applicationSamlSettingsClient,
+		ApplicationTemplatesClient:      applicationTemplatesClient,
+		DelegatedPermissionGrantsClient: delegatedPermissionGrantsClient,
+		DirectoryObjectsClient:          directoryObjectsClient,
+		DomainsClient:                   domainsClient,
+		ExtensionPropertiesClient:       extensionPropertiesClient,
+		ServicePrincipalsClient:         servicePrincipalsClient,
+		VerifiedPublisherClient:         verifiedPublisherClient,
 	}
 }
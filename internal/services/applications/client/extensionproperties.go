@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	ExtensionPropertyDataTypeBinary       = "Binary"
+	ExtensionPropertyDataTypeBoolean      = "Boolean"
+	ExtensionPropertyDataTypeDateTime     = "DateTime"
+	ExtensionPropertyDataTypeInteger      = "Integer"
+	ExtensionPropertyDataTypeLargeInteger = "LargeInteger"
+	ExtensionPropertyDataTypeString       = "String"
+)
+
+const (
+	ExtensionPropertyTargetObjectAdministrativeUnit = "AdministrativeUnit"
+	ExtensionPropertyTargetObjectApplication        = "Application"
+	ExtensionPropertyTargetObjectDevice             = "Device"
+	ExtensionPropertyTargetObjectGroup              = "Group"
+	ExtensionPropertyTargetObjectOrganization       = "Organization"
+	ExtensionPropertyTargetObjectUser               = "User"
+)
+
+// ExtensionProperty describes a directory schema extension registered by an application.
+type ExtensionProperty struct {
+	ID                     *string   `json:"id,omitempty"`
+	AppDisplayName         *string   `json:"appDisplayName,omitempty"`
+	DataType               *string   `json:"dataType,omitempty"`
+	IsSyncedFromOnPremises *bool     `json:"isSyncedFromOnPremises,omitempty"`
+	Name                   *string   `json:"name,omitempty"`
+	TargetObjects          *[]string `json:"targetObjects,omitempty"`
+}
+
+// ExtensionPropertiesClient manages the directory schema extensions of an application, which are not yet supported by
+// the Hamilton SDK. Extension properties cannot be updated, only created and deleted.
+type ExtensionPropertiesClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewExtensionPropertiesClient(tenantId string) *ExtensionPropertiesClient {
+	return &ExtensionPropertiesClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create registers a new extension property for an application.
+func (c *ExtensionPropertiesClient) Create(ctx context.Context, applicationId string, extensionProperty ExtensionProperty) (*ExtensionProperty, int, error) {
+	var status int
+
+	body, err := json.Marshal(extensionProperty)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/extensionProperties", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ExtensionPropertiesClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newExtensionProperty ExtensionProperty
+	if err := json.Unmarshal(respBody, &newExtensionProperty); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newExtensionProperty, status, nil
+}
+
+// List returns the extension properties registered by an application.
+func (c *ExtensionPropertiesClient) List(ctx context.Context, applicationId string) (*[]ExtensionProperty, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/extensionProperties", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ExtensionPropertiesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ExtensionProperties []ExtensionProperty `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ExtensionProperties, status, nil
+}
+
+// Get retrieves an extension property for an application.
+func (c *ExtensionPropertiesClient) Get(ctx context.Context, applicationId, extensionPropertyId string) (*ExtensionProperty, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationId, extensionPropertyId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ExtensionPropertiesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var extensionProperty ExtensionProperty
+	if err := json.Unmarshal(respBody, &extensionProperty); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &extensionProperty, status, nil
+}
+
+// Delete removes an extension property from an application.
+func (c *ExtensionPropertiesClient) Delete(ctx context.Context, applicationId, extensionPropertyId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationId, extensionPropertyId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ExtensionPropertiesClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
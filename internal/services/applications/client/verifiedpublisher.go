@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// VerifiedPublisherClient manages the verified publisher for an application, using the setVerifiedPublisher and
+// unsetVerifiedPublisher actions which are not yet supported by the Hamilton SDK.
+type VerifiedPublisherClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewVerifiedPublisherClient(tenantId string) *VerifiedPublisherClient {
+	return &VerifiedPublisherClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Set sets the verified publisher for an application, using the publisher's Microsoft Partner Network (MPN) ID.
+func (c *VerifiedPublisherClient) Set(ctx context.Context, applicationId, verifiedPublisherId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		VerifiedPublisherId string `json:"verifiedPublisherId"`
+	}{
+		VerifiedPublisherId: verifiedPublisherId,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/setVerifiedPublisher", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("VerifiedPublisherClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Unset removes the verified publisher from an application.
+func (c *VerifiedPublisherClient) Unset(ctx context.Context, applicationId string) (int, error) {
+	_, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/unsetVerifiedPublisher", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("VerifiedPublisherClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
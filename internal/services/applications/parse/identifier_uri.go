@@ -0,0 +1,50 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type ApplicationIdentifierUriId struct {
+	ObjectId      string
+	IdentifierUri string
+}
+
+func NewApplicationIdentifierUriID(objectId, identifierUri string) ApplicationIdentifierUriId {
+	return ApplicationIdentifierUriId{
+		ObjectId:      objectId,
+		IdentifierUri: identifierUri,
+	}
+}
+
+func (id ApplicationIdentifierUriId) String() string {
+	return id.ObjectId + "/identifierUri/" + id.IdentifierUri
+}
+
+// ApplicationIdentifierUriID parses an ID in the format {objectId}/identifierUri/{identifierUri}. Since identifier URIs
+// usually contain slashes, everything following the type segment is treated as the URI.
+func ApplicationIdentifierUriID(idString string) (*ApplicationIdentifierUriId, error) {
+	parts := strings.SplitN(idString, "/", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("unable to parse Identifier URI ID: ID should be in the format {objectId}/identifierUri/{identifierUri} - but got %q", idString)
+	}
+
+	if _, err := uuid.ParseUUID(parts[0]); err != nil {
+		return nil, fmt.Errorf("unable to parse Identifier URI ID: Object ID isn't a valid UUID (%q): %+v", parts[0], err)
+	}
+
+	if parts[1] != "identifierUri" {
+		return nil, fmt.Errorf("unable to parse Identifier URI ID: Type in {objectId}/{type}/{identifierUri} was expected to be identifierUri, got %s", parts[1])
+	}
+
+	if parts[2] == "" {
+		return nil, fmt.Errorf("unable to parse Identifier URI ID: Identifier URI should not be empty")
+	}
+
+	return &ApplicationIdentifierUriId{
+		ObjectId:      parts[0],
+		IdentifierUri: parts[2],
+	}, nil
+}
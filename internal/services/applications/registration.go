@@ -22,6 +22,9 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azuread_application":                   applicationDataSource(),
+		"azuread_application_api_permissions":   applicationApiPermissionsDataSource(),
+		"azuread_application_credentials":       applicationCredentialsDataSource(),
+		"azuread_application_permission_ids":    applicationPermissionIdsDataSource(),
 		"azuread_application_published_app_ids": applicationPublishedAppIdsDataSource(),
 		"azuread_application_template":          applicationTemplateDataSource(),
 	}
@@ -31,8 +34,11 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azuread_application":                               applicationResource(),
+		"azuread_application_app_role":                      applicationAppRoleResource(),
 		"azuread_application_certificate":                   applicationCertificateResource(),
+		"azuread_application_extension":                     applicationExtensionResource(),
 		"azuread_application_federated_identity_credential": applicationFederatedIdentityCredentialResource(),
+		"azuread_application_identifier_uri":                applicationIdentifierUriResource(),
 		"azuread_application_password":                      applicationPasswordResource(),
 		"azuread_application_pre_authorized":                applicationPreAuthorizedResource(),
 	}
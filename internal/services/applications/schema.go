@@ -42,6 +42,7 @@ func schemaOptionalClaims() *schema.Schema {
 						Type: schema.TypeString,
 						ValidateFunc: validation.StringInSlice(
 							[]string{
+								"cloud_displayname",
 								"dns_domain_and_sam_account_name",
 								"emit_as_roles",
 								"include_externally_authenticated_upn",
@@ -59,3 +60,34 @@ func schemaOptionalClaims() *schema.Schema {
 		},
 	}
 }
+
+// schemaCredentialMetadata describes a certificate or password credential, without any secret material
+func schemaCredentialMetadata(kind string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Description: "The unique key ID of the " + kind,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the " + kind,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"start_date": {
+				Description: "The start date from which the " + kind + " is valid, formatted as an RFC3339 date string",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"end_date": {
+				Description: "The end date until which the " + kind + " is valid, formatted as an RFC3339 date string",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
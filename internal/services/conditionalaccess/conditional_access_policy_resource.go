@@ -442,6 +442,19 @@ func conditionalAccessPolicyCustomizeDiff(ctx context.Context, diff *schema.Reso
 		diff.ForceNew("conditions.0.devices.0.filter")
 	}
 
+	// A policy must have at least one grant control or session control, otherwise the API rejects it
+	if diff.NewValueKnown("grant_controls") && diff.NewValueKnown("session_controls") &&
+		!conditionalAccessPolicyHasGrantControls(diff.Get("grant_controls").([]interface{})) &&
+		!conditionalAccessPolicyHasSessionControls(diff.Get("session_controls").([]interface{})) {
+		return fmt.Errorf("at least one grant control (`built_in_controls`, `custom_authentication_factors` or `terms_of_use`) or session control must be specified")
+	}
+
+	if diff.NewValueKnown("conditions.0.users") {
+		if err := conditionalAccessPolicyValidateUsers(diff.Get("conditions.0.users").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -550,7 +563,7 @@ func conditionalAccessPolicyResourceUpdate(ctx context.Context, d *schema.Resour
 		return tf.ErrorDiagF(err, "waiting for update of conditional access policy with ID %q", d.Id())
 	}
 
-	return nil
+	return conditionalAccessPolicyResourceRead(ctx, d, meta)
 }
 
 func conditionalAccessPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
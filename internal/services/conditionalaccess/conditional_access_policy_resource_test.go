@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -244,6 +245,78 @@ func TestAccConditionalAccessPolicy_sessionControlsDisabled(t *testing.T) {
 	})
 }
 
+func TestAccConditionalAccessPolicy_reportOnlyToEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy", "test")
+	r := ConditionalAccessPolicyResource{}
+	var objectId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.stateForEmptyGroup(data, "enabledForReportingButNotEnforced"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("enabledForReportingButNotEnforced"),
+				r.objectId(data, &objectId, false),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stateForEmptyGroup(data, "enabled"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("enabled"),
+				r.objectId(data, &objectId, true),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stateForEmptyGroup(data, "disabled"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("disabled"),
+				r.objectId(data, &objectId, true),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConditionalAccessPolicy_noControls(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy", "test")
+	r := ConditionalAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.noControls(data),
+			ExpectError: regexp.MustCompile("at least one grant control"),
+		},
+	})
+}
+
+func TestAccConditionalAccessPolicy_usersEmptyIncludes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy", "test")
+	r := ConditionalAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.usersEmptyIncludes(data),
+			ExpectError: regexp.MustCompile("must include at least one user, group or role"),
+		},
+	})
+}
+
+func TestAccConditionalAccessPolicy_usersExcludeEverything(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy", "test")
+	r := ConditionalAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.usersExcludeEverything(data),
+			ExpectError: regexp.MustCompile("the policy would not apply to anyone"),
+		},
+	})
+}
+
 func (r ConditionalAccessPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	var id *string
 
@@ -259,6 +332,20 @@ func (r ConditionalAccessPolicyResource) Exists(ctx context.Context, clients *cl
 	return utils.Bool(id != nil && *id == state.ID), nil
 }
 
+func (ConditionalAccessPolicyResource) objectId(data acceptance.TestData, objectId *string, compare bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		if compare && policy.Primary.ID != *objectId {
+			return fmt.Errorf("expected policy to be updated in place with ID %q, got %q", *objectId, policy.Primary.ID)
+		}
+		*objectId = policy.Primary.ID
+		return nil
+	}
+}
+
 func (ConditionalAccessPolicyResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_conditional_access_policy" "test" {
@@ -542,3 +629,114 @@ resource "azuread_conditional_access_policy" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (ConditionalAccessPolicyResource) stateForEmptyGroup(data acceptance.TestData, state string) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-CONPOLICY-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_conditional_access_policy" "test" {
+  display_name = "acctest-CONPOLICY-%[1]d"
+  state        = "%[2]s"
+
+  conditions {
+    client_app_types = ["browser"]
+
+    applications {
+      included_applications = ["All"]
+    }
+
+    users {
+      included_groups = [azuread_group.test.object_id]
+    }
+  }
+
+  grant_controls {
+    operator          = "OR"
+    built_in_controls = ["mfa"]
+  }
+}
+`, data.RandomInteger, state)
+}
+
+func (ConditionalAccessPolicyResource) noControls(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_conditional_access_policy" "test" {
+  display_name = "acctest-CONPOLICY-%[1]d"
+  state        = "disabled"
+
+  conditions {
+    client_app_types = ["browser"]
+
+    applications {
+      included_applications = ["All"]
+    }
+
+    users {
+      included_users = ["All"]
+    }
+  }
+
+  grant_controls {
+    operator          = "OR"
+    built_in_controls = []
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ConditionalAccessPolicyResource) usersEmptyIncludes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_conditional_access_policy" "test" {
+  display_name = "acctest-CONPOLICY-%[1]d"
+  state        = "disabled"
+
+  conditions {
+    client_app_types = ["browser"]
+
+    applications {
+      included_applications = ["All"]
+    }
+
+    users {
+      included_users  = []
+      included_groups = []
+    }
+  }
+
+  grant_controls {
+    operator          = "OR"
+    built_in_controls = ["block"]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ConditionalAccessPolicyResource) usersExcludeEverything(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_conditional_access_policy" "test" {
+  display_name = "acctest-CONPOLICY-%[1]d"
+  state        = "disabled"
+
+  conditions {
+    client_app_types = ["browser"]
+
+    applications {
+      included_applications = ["All"]
+    }
+
+    users {
+      included_users = ["GuestsOrExternalUsers"]
+      excluded_users = ["GuestsOrExternalUsers"]
+    }
+  }
+
+  grant_controls {
+    operator          = "OR"
+    built_in_controls = ["block"]
+  }
+}
+`, data.RandomInteger)
+}
@@ -1,6 +1,10 @@
 package conditionalaccess
 
 import (
+	"fmt"
+	"net"
+	"strings"
+
 	"github.com/manicminer/hamilton/msgraph"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
@@ -360,6 +364,112 @@ func expandConditionalAccessGrantControls(in []interface{}) *msgraph.Conditional
 	return &result
 }
 
+// conditionalAccessPolicyHasGrantControls returns true when any grant controls are configured
+func conditionalAccessPolicyHasGrantControls(in []interface{}) bool {
+	if len(in) == 0 || in[0] == nil {
+		return false
+	}
+	config := in[0].(map[string]interface{})
+
+	for _, k := range []string{"built_in_controls", "custom_authentication_factors", "terms_of_use"} {
+		if v, ok := config[k].([]interface{}); ok && len(v) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// conditionalAccessPolicyHasSessionControls returns true when any session controls are enabled
+func conditionalAccessPolicyHasSessionControls(in []interface{}) bool {
+	if len(in) == 0 || in[0] == nil {
+		return false
+	}
+	config := in[0].(map[string]interface{})
+
+	if v, ok := config["application_enforced_restrictions_enabled"].(bool); ok && v {
+		return true
+	}
+	if v, ok := config["cloud_app_security_policy"].(string); ok && v != "" {
+		return true
+	}
+	if v, ok := config["persistent_browser_mode"].(string); ok && v != "" {
+		return true
+	}
+	if v, ok := config["sign_in_frequency"].(int); ok && v > 0 {
+		return true
+	}
+
+	return false
+}
+
+// conditionalAccessPolicyValidateUsers returns an error when the users condition does not include anyone, or when
+// every included user, group and role is also excluded, since the API rejects a policy that applies to nobody.
+// Values which are unknown are treated as not matching anything, so that validation is deferred to the API.
+func conditionalAccessPolicyValidateUsers(in []interface{}) error {
+	if len(in) == 0 || in[0] == nil {
+		return fmt.Errorf("the `users` block in `conditions` must include at least one user, group or role")
+	}
+	config := in[0].(map[string]interface{})
+
+	unknown := false
+	values := func(k string) []string {
+		result := make([]string, 0)
+		if v, ok := config[k].([]interface{}); ok {
+			for _, raw := range v {
+				if s, ok := raw.(string); ok {
+					if s == tf.PluginSdkUnknownValue {
+						unknown = true
+					}
+					result = append(result, s)
+				}
+			}
+		}
+		return result
+	}
+
+	includedUsers, excludedUsers := values("included_users"), values("excluded_users")
+	includedGroups, excludedGroups := values("included_groups"), values("excluded_groups")
+	includedRoles, excludedRoles := values("included_roles"), values("excluded_roles")
+
+	if len(includedUsers) == 0 && len(includedGroups) == 0 && len(includedRoles) == 0 {
+		return fmt.Errorf("the `users` block in `conditions` must include at least one user, group or role. Specify `included_users`, `included_groups` or `included_roles`, e.g. `included_users = [\"All\"]` or `included_users = [\"GuestsOrExternalUsers\"]`")
+	}
+
+	if unknown {
+		return nil
+	}
+
+	// Including "All" users cannot be fully negated by exclusions, and including "None" is a deliberate no-op
+	for _, v := range includedUsers {
+		if strings.EqualFold(v, "All") || strings.EqualFold(v, "None") {
+			return nil
+		}
+	}
+
+	excluded := func(included, excluded []string) bool {
+		for _, i := range included {
+			found := false
+			for _, e := range excluded {
+				if strings.EqualFold(i, e) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	if excluded(includedUsers, excludedUsers) && excluded(includedGroups, excludedGroups) && excluded(includedRoles, excludedRoles) {
+		return fmt.Errorf("every user, group and role included in the `users` block in `conditions` is also excluded, so the policy would not apply to anyone. Remove one or more values from `excluded_users`, `excluded_groups` or `excluded_roles`")
+	}
+
+	return nil
+}
+
 func expandConditionalAccessSessionControls(in []interface{}) *msgraph.ConditionalAccessSessionControls {
 	result := msgraph.ConditionalAccessSessionControls{}
 
@@ -461,3 +571,27 @@ func expandIPNamedLocationIPRange(in []interface{}) *[]msgraph.IPNamedLocationIP
 
 	return &result
 }
+
+// namedLocationValidateIPRanges returns an error when any of the provided CIDR ranges overlap. Values which are
+// unknown or cannot be parsed are skipped, since these are validated separately.
+func namedLocationValidateIPRanges(in []interface{}) error {
+	networks := make([]*net.IPNet, 0)
+	for _, raw := range in {
+		v, ok := raw.(string)
+		if !ok || !tf.ValueIsNotEmptyOrUnknown(v) {
+			continue
+		}
+		_, network, err := net.ParseCIDR(v)
+		if err != nil {
+			continue
+		}
+		for _, existing := range networks {
+			if existing.Contains(network.IP) || network.Contains(existing.IP) {
+				return fmt.Errorf("the IP range %q overlaps with %q", v, existing.String())
+			}
+		}
+		networks = append(networks, network)
+	}
+
+	return nil
+}
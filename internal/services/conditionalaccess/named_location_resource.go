@@ -30,6 +30,8 @@ func namedLocationResource() *schema.Resource {
 		UpdateContext: namedLocationResourceUpdate,
 		DeleteContext: namedLocationResourceDelete,
 
+		CustomizeDiff: namedLocationResourceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -55,7 +57,6 @@ func namedLocationResource() *schema.Resource {
 			"ip": {
 				Type:         schema.TypeList,
 				Optional:     true,
-				ForceNew:     true,
 				MaxItems:     1,
 				ExactlyOneOf: []string{"ip", "country"},
 				Elem: &schema.Resource{
@@ -64,7 +65,8 @@ func namedLocationResource() *schema.Resource {
 							Type:     schema.TypeList,
 							Required: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validate.IsCIDR,
 							},
 						},
 
@@ -79,7 +81,6 @@ func namedLocationResource() *schema.Resource {
 			"country": {
 				Type:         schema.TypeList,
 				Optional:     true,
-				ForceNew:     true,
 				MaxItems:     1,
 				ExactlyOneOf: []string{"ip", "country"},
 				Elem: &schema.Resource{
@@ -103,6 +104,24 @@ func namedLocationResource() *schema.Resource {
 	}
 }
 
+func namedLocationResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The type of an existing named location cannot be changed
+	if diff.Id() != "" {
+		if old, new := diff.GetChange("ip.#"); old.(int) != new.(int) {
+			diff.ForceNew("ip")
+		}
+		if old, new := diff.GetChange("country.#"); old.(int) != new.(int) {
+			diff.ForceNew("country")
+		}
+	}
+
+	if err := namedLocationValidateIPRanges(diff.Get("ip.0.ip_ranges").([]interface{})); err != nil {
+		return fmt.Errorf("validating `ip_ranges`: %v", err)
+	}
+
+	return nil
+}
+
 func namedLocationResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ConditionalAccess.NamedLocationsClient
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -78,6 +79,44 @@ func TestAccNamedLocation_updateIP(t *testing.T) {
 	})
 }
 
+func TestAccNamedLocation_addIPRangeInPlace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+	r := NamedLocationResource{}
+	var objectId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basicIP(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip.0.ip_ranges.#").HasValue("2"),
+				r.objectId(data, &objectId, false),
+			),
+		},
+		{
+			Config: r.completeIP(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip.0.ip_ranges.#").HasValue("4"),
+				r.objectId(data, &objectId, true),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNamedLocation_overlappingIPRanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+	r := NamedLocationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.overlappingIP(data),
+			ExpectError: regexp.MustCompile("the IP range \"10.1.0.0/24\" overlaps with \"10.0.0.0/8\""),
+		},
+	})
+}
+
 func TestAccNamedLocation_basicCountry(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
 	r := NamedLocationResource{}
@@ -156,6 +195,20 @@ func (r NamedLocationResource) Exists(ctx context.Context, clients *clients.Clie
 	return nil, fmt.Errorf("Unable to match object ID %q to a known type", state.ID)
 }
 
+func (NamedLocationResource) objectId(data acceptance.TestData, objectId *string, compare bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		location, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		if compare && location.Primary.ID != *objectId {
+			return fmt.Errorf("expected named location to be updated in place with object ID %q, got %q", *objectId, location.Primary.ID)
+		}
+		*objectId = location.Primary.ID
+		return nil
+	}
+}
+
 func (NamedLocationResource) basicIP(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_named_location" "test" {
@@ -187,6 +240,20 @@ resource "azuread_named_location" "test" {
 `, data.RandomInteger)
 }
 
+func (NamedLocationResource) overlappingIP(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_named_location" "test" {
+  display_name = "acctestNLIP-%[1]d"
+  ip {
+    ip_ranges = [
+      "10.0.0.0/8",
+      "10.1.0.0/24",
+    ]
+  }
+}
+`, data.RandomInteger)
+}
+
 func (NamedLocationResource) basicCountry(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_named_location" "test" {
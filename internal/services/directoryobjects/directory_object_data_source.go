@@ -0,0 +1,78 @@
+package directoryobjects
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func directoryObjectDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: directoryObjectDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Description:      "The object ID of the directory object",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Description: "The display name of the directory object",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"type": {
+				Description: "The type of the directory object, e.g. `User`, `Group` or `ServicePrincipal`",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func directoryObjectDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.DirectoryObjectsClient
+	client.BaseClient.DisableRetries = true
+
+	objectId := d.Get("object_id").(string)
+
+	directoryObject, status, err := directoryObjectGet(ctx, client, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "object_id", "No directory object found with object ID: %q", objectId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving directory object with object ID: %q", objectId)
+	}
+	if directoryObject == nil {
+		return tf.ErrorDiagPathF(nil, "object_id", "Directory object not found with object ID: %q", objectId)
+	}
+	if directoryObject.ID == nil {
+		return tf.ErrorDiagF(errors.New("API returned directory object with nil object ID"), "Bad API Response")
+	}
+	if directoryObject.ODataType == nil {
+		return tf.ErrorDiagF(errors.New("API returned directory object with nil OData type"), "Bad API Response")
+	}
+
+	d.SetId(*directoryObject.ID)
+
+	tf.Set(d, "display_name", directoryObject.DisplayName)
+	tf.Set(d, "object_id", directoryObject.ID)
+	tf.Set(d, "type", directoryObjectTypeName(*directoryObject.ODataType))
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package directoryobjects_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryObjectDataSource struct{}
+
+func TestAccDirectoryObjectDataSource_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object", "test")
+	r := DirectoryObjectDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.group(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("object_id").Exists(),
+			check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+			check.That(data.ResourceName).Key("type").HasValue("Group"),
+		),
+	}})
+}
+
+func TestAccDirectoryObjectDataSource_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object", "test")
+	r := DirectoryObjectDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.servicePrincipal(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("object_id").Exists(),
+			check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger)),
+			check.That(data.ResourceName).Key("type").HasValue("ServicePrincipal"),
+		),
+	}})
+}
+
+func TestAccDirectoryObjectDataSource_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object", "test")
+	r := DirectoryObjectDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.user(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("object_id").Exists(),
+			check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestUser-%d", data.RandomInteger)),
+			check.That(data.ResourceName).Key("type").HasValue("User"),
+		),
+	}})
+}
+
+func TestAccDirectoryObjectDataSource_nonexistent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config:      DirectoryObjectDataSource{}.nonexistent(),
+		ExpectError: regexp.MustCompile("No directory object found with object ID"),
+	}})
+}
+
+func (DirectoryObjectDataSource) group(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+data "azuread_directory_object" "test" {
+  object_id = azuread_group.test.object_id
+}
+`, data.RandomInteger)
+}
+
+func (DirectoryObjectDataSource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+data "azuread_directory_object" "test" {
+  object_id = azuread_service_principal.test.object_id
+}
+`, data.RandomInteger)
+}
+
+func (DirectoryObjectDataSource) user(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+data "azuread_directory_object" "test" {
+  object_id = azuread_user.test.object_id
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (DirectoryObjectDataSource) nonexistent() string {
+	return `
+data "azuread_directory_object" "test" {
+  object_id = "00000000-0000-0000-0000-000000000000"
+}
+`
+}
@@ -0,0 +1,172 @@
+package directoryobjects
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+// directoryObjectsGetByIdsLimit is the maximum number of IDs that can be resolved in a single getByIds request
+const directoryObjectsGetByIdsLimit = 1000
+
+func directoryObjectsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: directoryObjectsDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_ids": {
+				Description: "The object IDs of the directory objects to resolve",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+
+			"types": {
+				Description: "The types of directory object to resolve, used to narrow the search. When not specified, all supported types are resolved",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Application",
+						"Device",
+						"Group",
+						"OrgContact",
+						"ServicePrincipal",
+						"User",
+					}, false),
+				},
+			},
+
+			"ignore_missing": {
+				Description: "Ignore missing objects and return the objects that were found",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"objects": {
+				Description: "A list of resolved directory objects, in the same order as `object_ids`",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_id": {
+							Description: "The object ID of the directory object",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the directory object",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The type of the directory object, e.g. `User`, `Group` or `ServicePrincipal`",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func directoryObjectsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.DirectoryObjectsClient
+
+	objectIds := tf.ExpandStringSlice(d.Get("object_ids").([]interface{}))
+	ignoreMissing := d.Get("ignore_missing").(bool)
+
+	// The API expects the short OData type names, e.g. `servicePrincipal`
+	types := make([]odata.ShortType, 0)
+	for _, t := range tf.ExpandStringSlice(d.Get("types").(*schema.Set).List()) {
+		types = append(types, strings.ToLower(t[:1])+t[1:])
+	}
+
+	found := make(map[string]directoryObject)
+	for i := 0; i < len(objectIds); i += directoryObjectsGetByIdsLimit {
+		end := i + directoryObjectsGetByIdsLimit
+		if end > len(objectIds) {
+			end = len(objectIds)
+		}
+
+		result, _, err := directoryObjectsGetByIds(ctx, client, objectIds[i:end], types)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving directory objects")
+		}
+		if result == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+
+		for _, object := range *result {
+			if object.ID == nil {
+				return tf.ErrorDiagF(errors.New("API returned directory object with nil object ID"), "Bad API Response")
+			}
+			found[strings.ToLower(*object.ID)] = object
+		}
+	}
+
+	ids := make([]string, 0)
+	objects := make([]map[string]interface{}, 0)
+	for _, id := range objectIds {
+		object, ok := found[strings.ToLower(id)]
+		if !ok {
+			if ignoreMissing {
+				continue
+			}
+			return tf.ErrorDiagPathF(nil, "object_ids", "No directory object found with object ID: %q", id)
+		}
+
+		displayName := ""
+		if object.DisplayName != nil {
+			displayName = *object.DisplayName
+		}
+		objectType := ""
+		if object.ODataType != nil {
+			objectType = directoryObjectTypeName(*object.ODataType)
+		}
+
+		ids = append(ids, *object.ID)
+		objects = append(objects, map[string]interface{}{
+			"object_id":    *object.ID,
+			"display_name": displayName,
+			"type":         objectType,
+		})
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(ids, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId(fmt.Sprintf("directoryObjects#%s", base64.URLEncoding.EncodeToString(h.Sum(nil))))
+
+	tf.Set(d, "objects", objects)
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package directoryobjects_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryObjectsDataSource struct{}
+
+func TestAccDirectoryObjectsDataSource_mixed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_objects", "test")
+	r := DirectoryObjectsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.mixed(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("objects.#").HasValue("3"),
+			check.That(data.ResourceName).Key("objects.0.display_name").HasValue(fmt.Sprintf("acctestUser-%d", data.RandomInteger)),
+			check.That(data.ResourceName).Key("objects.0.type").HasValue("User"),
+			check.That(data.ResourceName).Key("objects.1.display_name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+			check.That(data.ResourceName).Key("objects.1.type").HasValue("Group"),
+			check.That(data.ResourceName).Key("objects.2.display_name").HasValue(fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger)),
+			check.That(data.ResourceName).Key("objects.2.type").HasValue("ServicePrincipal"),
+		),
+	}})
+}
+
+func TestAccDirectoryObjectsDataSource_types(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_objects", "test")
+	r := DirectoryObjectsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.types(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("objects.#").HasValue("1"),
+			check.That(data.ResourceName).Key("objects.0.type").HasValue("Group"),
+		),
+	}})
+}
+
+func TestAccDirectoryObjectsDataSource_nonexistent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_objects", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config:      DirectoryObjectsDataSource{}.nonexistent(false),
+		ExpectError: regexp.MustCompile("No directory object found with object ID"),
+	}})
+}
+
+func TestAccDirectoryObjectsDataSource_nonexistentIgnoreMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_objects", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: DirectoryObjectsDataSource{}.nonexistent(true),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("objects.#").HasValue("0"),
+		),
+	}})
+}
+
+func (DirectoryObjectsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r DirectoryObjectsDataSource) mixed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_objects" "test" {
+  object_ids = [
+    azuread_user.test.object_id,
+    azuread_group.test.object_id,
+    azuread_service_principal.test.object_id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r DirectoryObjectsDataSource) types(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_objects" "test" {
+  object_ids     = [azuread_user.test.object_id, azuread_group.test.object_id]
+  types          = ["Group"]
+  ignore_missing = true
+}
+`, r.template(data))
+}
+
+func (DirectoryObjectsDataSource) nonexistent(ignoreMissing bool) string {
+	return fmt.Sprintf(`
+data "azuread_directory_objects" "test" {
+  object_ids     = ["00000000-0000-0000-0000-000000000000"]
+  ignore_missing = %[1]t
+}
+`, ignoreMissing)
+}
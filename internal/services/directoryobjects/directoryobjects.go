@@ -0,0 +1,106 @@
+package directoryobjects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+// directoryObject is a minimal representation of any directory object, including the properties common to all
+// principal types that are not modelled by msgraph.DirectoryObject
+type directoryObject struct {
+	ODataType   *odata.Type `json:"@odata.type,omitempty"`
+	ID          *string     `json:"id,omitempty"`
+	DisplayName *string     `json:"displayName,omitempty"`
+}
+
+func directoryObjectGet(ctx context.Context, client *msgraph.DirectoryObjectsClient, id string) (*directoryObject, int, error) {
+	resp, status, _, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData: odata.Query{
+			Metadata: odata.MetadataFull,
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directoryObjects/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DirectoryObjectsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var object directoryObject
+	if err := json.Unmarshal(respBody, &object); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &object, status, nil
+}
+
+// directoryObjectsGetByIds retrieves multiple directory objects in a single request, optionally narrowing the
+// resolution to the specified OData types. The API accepts at most 1000 IDs per request.
+func directoryObjectsGetByIds(ctx context.Context, client *msgraph.DirectoryObjectsClient, ids []string, types []odata.ShortType) (*[]directoryObject, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		IDs   []string          `json:"ids"`
+		Types []odata.ShortType `json:"types,omitempty"`
+	}{
+		IDs:   ids,
+		Types: types,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := client.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/directoryObjects/getByIds",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DirectoryObjectsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Objects []directoryObject `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Objects, status, nil
+}
+
+// directoryObjectTypeName returns a friendly type name for the provided OData type, e.g. `ServicePrincipal` for
+// `#microsoft.graph.servicePrincipal`, consistent with the principal types returned for app role assignments
+func directoryObjectTypeName(t odata.Type) string {
+	name := strings.TrimPrefix(string(t), "#microsoft.graph.")
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
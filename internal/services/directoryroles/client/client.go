@@ -7,12 +7,20 @@ import (
 )
 
 type Client struct {
+	AdministrativeUnitsClient    *msgraph.AdministrativeUnitsClient
 	DirectoryObjectsClient       *msgraph.DirectoryObjectsClient
 	DirectoryRolesClient         *msgraph.DirectoryRolesClient
 	DirectoryRoleTemplatesClient *msgraph.DirectoryRoleTemplatesClient
+	RoleAssignmentsClient        *msgraph.RoleAssignmentsClient
+
+	RoleEligibilityScheduleClient        *RoleEligibilityScheduleClient
+	RoleEligibilityScheduleRequestClient *RoleEligibilityScheduleRequestClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	administrativeUnitsClient := msgraph.NewAdministrativeUnitsClient(o.TenantID)
+	o.ConfigureClient(&administrativeUnitsClient.BaseClient)
+
 	directoryObjectsClient := msgraph.NewDirectoryObjectsClient(o.TenantID)
 	o.ConfigureClient(&directoryObjectsClient.BaseClient)
 
@@ -22,9 +30,23 @@ func NewClient(o *common.ClientOptions) *Client {
 	directoryRoleTemplatesClient := msgraph.NewDirectoryRoleTemplatesClient(o.TenantID)
 	o.ConfigureClient(&directoryRoleTemplatesClient.BaseClient)
 
+	roleAssignmentsClient := msgraph.NewRoleAssignmentsClient(o.TenantID)
+	o.ConfigureClient(&roleAssignmentsClient.BaseClient)
+
+	roleEligibilityScheduleClient := NewRoleEligibilityScheduleClient(o.TenantID)
+	o.ConfigureClient(&roleEligibilityScheduleClient.BaseClient)
+
+	roleEligibilityScheduleRequestClient := NewRoleEligibilityScheduleRequestClient(o.TenantID)
+	o.ConfigureClient(&roleEligibilityScheduleRequestClient.BaseClient)
+
 	return &Client{
+		AdministrativeUnitsClient:    administrativeUnitsClient,
 		DirectoryObjectsClient:       directoryObjectsClient,
 		DirectoryRolesClient:         directoryRolesClient,
 		DirectoryRoleTemplatesClient: directoryRoleTemplatesClient,
+		RoleAssignmentsClient:        roleAssignmentsClient,
+
+		RoleEligibilityScheduleClient:        roleEligibilityScheduleClient,
+		RoleEligibilityScheduleRequestClient: roleEligibilityScheduleRequestClient,
 	}
 }
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+// UnifiedRoleEligibilitySchedule describes a current or future eligible role assignment, which is created when an
+// eligibility schedule request is provisioned. This is not yet modelled by the Hamilton SDK.
+type UnifiedRoleEligibilitySchedule struct {
+	ID               *string `json:"id,omitempty"`
+	DirectoryScopeId *string `json:"directoryScopeId,omitempty"`
+	PrincipalId      *string `json:"principalId,omitempty"`
+	RoleDefinitionId *string `json:"roleDefinitionId,omitempty"`
+	Status           *string `json:"status,omitempty"`
+}
+
+type RoleEligibilityScheduleClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewRoleEligibilityScheduleClient(tenantId string) *RoleEligibilityScheduleClient {
+	return &RoleEligibilityScheduleClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// List returns a list of UnifiedRoleEligibilitySchedules, optionally queried using OData.
+func (c *RoleEligibilityScheduleClient) List(ctx context.Context, query odata.Query) (*[]UnifiedRoleEligibilitySchedule, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/roleManagement/directory/roleEligibilitySchedules",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("RoleEligibilityScheduleClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Schedules []UnifiedRoleEligibilitySchedule `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Schedules, status, nil
+}
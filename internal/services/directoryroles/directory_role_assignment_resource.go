@@ -0,0 +1,200 @@
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const directoryRoleAssignmentAdministrativeUnitScopePrefix = "/administrativeUnits/"
+
+func directoryRoleAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: directoryRoleAssignmentResourceCreate,
+		ReadContext:   directoryRoleAssignmentResourceRead,
+		DeleteContext: directoryRoleAssignmentResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return errors.New("specified ID is empty")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Description:      "The template ID (for built-in roles) or object ID (for custom roles) of the directory role",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Description:      "The object ID of the principal to be assigned the directory role",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"directory_scope_object_id": {
+				Description:      "The object ID of an administrative unit to which the role assignment should be scoped. If omitted, the role is assigned for the whole tenant",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"directory_scope_id": {
+				Description: "The directory scope of the role assignment, e.g. `/` for the whole tenant or `/administrativeUnits/{id}` for an administrative unit",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func directoryRoleAssignmentResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.RoleAssignmentsClient
+	administrativeUnitsClient := meta.(*clients.Client).DirectoryRoles.AdministrativeUnitsClient
+
+	roleId := d.Get("role_id").(string)
+	principalId := d.Get("principal_object_id").(string)
+
+	directoryScopeId := "/"
+	if administrativeUnitId := d.Get("directory_scope_object_id").(string); administrativeUnitId != "" {
+		administrativeUnitsClient.BaseClient.DisableRetries = true
+		if _, status, err := administrativeUnitsClient.Get(ctx, administrativeUnitId, odata.Query{}); err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "directory_scope_object_id", "Administrative unit with object ID %q was not found", administrativeUnitId)
+			}
+			return tf.ErrorDiagPathF(err, "directory_scope_object_id", "Retrieving administrative unit with object ID %q", administrativeUnitId)
+		}
+		directoryScopeId = directoryRoleAssignmentAdministrativeUnitScopePrefix + administrativeUnitId
+	}
+
+	properties := msgraph.UnifiedRoleAssignment{
+		DirectoryScopeId: utils.String(directoryScopeId),
+		PrincipalId:      utils.String(principalId),
+		RoleDefinitionId: utils.String(roleId),
+	}
+
+	assignment, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not assign directory role %q to principal %q with scope %q", roleId, principalId, directoryScopeId)
+	}
+	if assignment.ID == nil || *assignment.ID == "" {
+		return tf.ErrorDiagF(errors.New("API returned role assignment with nil ID"), "Bad API Response")
+	}
+
+	d.SetId(*assignment.ID)
+
+	// Wait for role assignment to reflect
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return tf.ErrorDiagF(errors.New("context has no deadline"), "Waiting for role assignment %q to reflect", d.Id())
+	}
+	timeout := time.Until(deadline)
+	_, err = (&resource.StateChangeConf{
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   timeout,
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Refresh: func() (interface{}, string, error) {
+			client.BaseClient.DisableRetries = true
+			if _, status, err := client.Get(ctx, d.Id(), odata.Query{}); err != nil {
+				if status == http.StatusNotFound {
+					return "stub", "Waiting", nil
+				}
+				return nil, "Error", fmt.Errorf("retrieving role assignment")
+			}
+			return "stub", "Done", nil
+		},
+	}).WaitForStateContext(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for role assignment %q to reflect", d.Id())
+	}
+	client.BaseClient.DisableRetries = false
+
+	return directoryRoleAssignmentResourceRead(ctx, d, meta)
+}
+
+func directoryRoleAssignmentResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.RoleAssignmentsClient
+
+	assignment, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Directory role assignment with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving directory role assignment with ID: %q", d.Id())
+	}
+
+	directoryScopeId := ""
+	directoryScopeObjectId := ""
+	if assignment.DirectoryScopeId != nil {
+		directoryScopeId = *assignment.DirectoryScopeId
+		if strings.HasPrefix(directoryScopeId, directoryRoleAssignmentAdministrativeUnitScopePrefix) {
+			directoryScopeObjectId = strings.TrimPrefix(directoryScopeId, directoryRoleAssignmentAdministrativeUnitScopePrefix)
+		}
+	}
+
+	tf.Set(d, "directory_scope_id", directoryScopeId)
+	tf.Set(d, "directory_scope_object_id", directoryScopeObjectId)
+	tf.Set(d, "principal_object_id", assignment.PrincipalId)
+	tf.Set(d, "role_id", assignment.RoleDefinitionId)
+
+	return nil
+}
+
+func directoryRoleAssignmentResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.RoleAssignmentsClient
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting directory role assignment with ID %q, got status %d", d.Id(), status)
+	}
+
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		client.BaseClient.DisableRetries = true
+		if _, status, err := client.Get(ctx, d.Id(), odata.Query{}); err != nil {
+			if status == http.StatusNotFound {
+				return utils.Bool(false), nil
+			}
+			return nil, err
+		}
+		return utils.Bool(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of directory role assignment with ID %q", d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package directoryroles_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type DirectoryRoleAssignmentResource struct{}
+
+// Global Reader cannot be scoped to an administrative unit, so the scoped test uses User Administrator
+const (
+	directoryRoleAssignmentGlobalReaderTemplateId      = "f2ef992c-3afb-46b9-b7cf-a126ee74c451"
+	directoryRoleAssignmentUserAdministratorTemplateId = "fe930be7-5e62-47db-91af-98c3a49a38b1"
+)
+
+func TestAccDirectoryRoleAssignment_tenantWide(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment", "test")
+	r := DirectoryRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.tenantWide(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("directory_scope_id").HasValue("/"),
+				check.That(data.ResourceName).Key("directory_scope_object_id").IsEmpty(),
+				check.That(data.ResourceName).Key("role_id").HasValue(directoryRoleAssignmentGlobalReaderTemplateId),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleAssignment_administrativeUnit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment", "test")
+	r := DirectoryRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.administrativeUnit(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("directory_scope_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("directory_scope_id").MatchesRegex(regexp.MustCompile("^/administrativeUnits/")),
+				check.That(data.ResourceName).Key("role_id").HasValue(directoryRoleAssignmentUserAdministratorTemplateId),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleAssignment_administrativeUnitNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment", "test")
+	r := DirectoryRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.administrativeUnitNotFound(data, data.UUID()),
+			ExpectError: regexp.MustCompile("Administrative unit with object ID .* was not found"),
+		},
+	})
+}
+
+func (r DirectoryRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.DirectoryRoles.RoleAssignmentsClient
+	client.BaseClient.DisableRetries = true
+
+	assignment, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Directory role assignment with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve directory role assignment with ID %q: %+v", state.ID, err)
+	}
+	return utils.Bool(assignment.ID != nil && *assignment.ID == state.ID), nil
+}
+
+func (DirectoryRoleAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r DirectoryRoleAssignmentResource) tenantWide(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_assignment" "test" {
+  role_id             = "%[2]s"
+  principal_object_id = azuread_user.test.object_id
+}
+`, r.template(data), directoryRoleAssignmentGlobalReaderTemplateId)
+}
+
+func (r DirectoryRoleAssignmentResource) administrativeUnit(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestAdministrativeUnit-%[2]d"
+}
+
+resource "azuread_directory_role_assignment" "test" {
+  role_id                   = "%[3]s"
+  principal_object_id       = azuread_user.test.object_id
+  directory_scope_object_id = azuread_administrative_unit.test.object_id
+}
+`, r.template(data), data.RandomInteger, directoryRoleAssignmentUserAdministratorTemplateId)
+}
+
+func (r DirectoryRoleAssignmentResource) administrativeUnitNotFound(data acceptance.TestData, administrativeUnitId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_assignment" "test" {
+  role_id                   = "%[2]s"
+  principal_object_id       = azuread_user.test.object_id
+  directory_scope_object_id = "%[3]s"
+}
+`, r.template(data), directoryRoleAssignmentUserAdministratorTemplateId, administrativeUnitId)
+}
@@ -0,0 +1,288 @@
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func directoryRoleEligibilityScheduleRequestResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: directoryRoleEligibilityScheduleRequestResourceCreate,
+		ReadContext:   directoryRoleEligibilityScheduleRequestResourceRead,
+		DeleteContext: directoryRoleEligibilityScheduleRequestResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Description:      "The template ID (for built-in roles) or object ID (for custom roles) of the directory role",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Description:      "The object ID of the principal to be made eligible for the directory role",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"directory_scope_id": {
+				Description:      "The directory scope of the eligible role assignment, e.g. `/` for the whole tenant or `/administrativeUnits/{id}` for an administrative unit",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "/",
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"justification": {
+				Description:      "The justification for the eligible role assignment",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"start_date": {
+				Description:      "The start date and time of the eligibility, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`). Defaults to the time of creation",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"end_date": {
+				Description:      "The end date and time of the eligibility, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`). If omitted, the eligibility does not expire",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"status": {
+				Description: "The status of the eligibility schedule request",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func directoryRoleEligibilityScheduleRequestResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	requestClient := meta.(*clients.Client).DirectoryRoles.RoleEligibilityScheduleRequestClient
+	scheduleClient := meta.(*clients.Client).DirectoryRoles.RoleEligibilityScheduleClient
+
+	roleId := d.Get("role_id").(string)
+	principalId := d.Get("principal_object_id").(string)
+
+	schedule := client.RequestSchedule{
+		Expiration: &client.ExpirationPattern{
+			Type: utils.String(client.ExpirationPatternTypeNoExpiration),
+		},
+	}
+
+	startDate := time.Now().UTC()
+	if v, ok := d.GetOk("start_date"); ok {
+		var err error
+		startDate, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "start_date", "Unable to parse the provided start date %q", v)
+		}
+	}
+	schedule.StartDateTime = &startDate
+
+	if v, ok := d.GetOk("end_date"); ok {
+		endDate, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "end_date", "Unable to parse the provided end date %q", v)
+		}
+		if !endDate.After(startDate) {
+			return tf.ErrorDiagPathF(nil, "end_date", "The end date must be later than the start date")
+		}
+		schedule.Expiration = &client.ExpirationPattern{
+			EndDateTime: &endDate,
+			Type:        utils.String(client.ExpirationPatternTypeAfterDateTime),
+		}
+	}
+
+	properties := client.UnifiedRoleEligibilityScheduleRequest{
+		Action:           utils.String(client.UnifiedRoleScheduleRequestActionAdminAssign),
+		DirectoryScopeId: utils.String(d.Get("directory_scope_id").(string)),
+		PrincipalId:      utils.String(principalId),
+		RoleDefinitionId: utils.String(roleId),
+		ScheduleInfo:     &schedule,
+	}
+
+	if v, ok := d.GetOk("justification"); ok {
+		properties.Justification = utils.String(v.(string))
+	}
+
+	request, _, err := requestClient.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create eligibility schedule request for principal %q and directory role %q", principalId, roleId)
+	}
+	if request.ID == nil || *request.ID == "" {
+		return tf.ErrorDiagF(errors.New("API returned eligibility schedule request with nil ID"), "Bad API Response")
+	}
+
+	d.SetId(*request.ID)
+
+	// Once provisioned, the eligibility schedule is not always immediately available, so wait for it to appear
+	if request.Status != nil && *request.Status == client.UnifiedRoleScheduleRequestStatusProvisioned {
+		if err := helpers.WaitForUpdate(ctx, 1, func(ctx context.Context) (*bool, error) {
+			exists, err := directoryRoleEligibilityScheduleExists(ctx, scheduleClient, principalId, roleId, d.Get("directory_scope_id").(string))
+			return &exists, err
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for eligibility schedule for principal %q and directory role %q", principalId, roleId)
+		}
+	}
+
+	return directoryRoleEligibilityScheduleRequestResourceRead(ctx, d, meta)
+}
+
+func directoryRoleEligibilityScheduleRequestResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	requestClient := meta.(*clients.Client).DirectoryRoles.RoleEligibilityScheduleRequestClient
+	scheduleClient := meta.(*clients.Client).DirectoryRoles.RoleEligibilityScheduleClient
+
+	request, status, err := requestClient.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Eligibility schedule request with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving eligibility schedule request with ID: %q", d.Id())
+	}
+
+	if request.Status != nil {
+		switch *request.Status {
+		case client.UnifiedRoleScheduleRequestStatusCanceled, client.UnifiedRoleScheduleRequestStatusDenied,
+			client.UnifiedRoleScheduleRequestStatusFailed, client.UnifiedRoleScheduleRequestStatusRevoked:
+			log.Printf("[DEBUG] Eligibility schedule request with ID %q has status %q - removing from state", d.Id(), *request.Status)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// The request is retained after the eligibility is revoked or expires, so check that the resulting schedule still exists
+	if request.Status != nil && *request.Status == client.UnifiedRoleScheduleRequestStatusProvisioned &&
+		request.PrincipalId != nil && request.RoleDefinitionId != nil && request.DirectoryScopeId != nil {
+		exists, err := directoryRoleEligibilityScheduleExists(ctx, scheduleClient, *request.PrincipalId, *request.RoleDefinitionId, *request.DirectoryScopeId)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving eligibility schedule for eligibility schedule request with ID: %q", d.Id())
+		}
+		if !exists {
+			log.Printf("[DEBUG] Eligibility schedule for eligibility schedule request with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+	}
+
+	startDate, endDate := "", ""
+	if request.ScheduleInfo != nil {
+		if request.ScheduleInfo.StartDateTime != nil {
+			startDate = request.ScheduleInfo.StartDateTime.Format(time.RFC3339)
+		}
+		if request.ScheduleInfo.Expiration != nil && request.ScheduleInfo.Expiration.EndDateTime != nil {
+			endDate = request.ScheduleInfo.Expiration.EndDateTime.Format(time.RFC3339)
+		}
+	}
+
+	tf.Set(d, "directory_scope_id", request.DirectoryScopeId)
+	tf.Set(d, "end_date", endDate)
+	tf.Set(d, "justification", request.Justification)
+	tf.Set(d, "principal_object_id", request.PrincipalId)
+	tf.Set(d, "role_id", request.RoleDefinitionId)
+	tf.Set(d, "start_date", startDate)
+	tf.Set(d, "status", request.Status)
+
+	return nil
+}
+
+func directoryRoleEligibilityScheduleRequestResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	requestClient := meta.(*clients.Client).DirectoryRoles.RoleEligibilityScheduleRequestClient
+
+	roleId := d.Get("role_id").(string)
+	principalId := d.Get("principal_object_id").(string)
+
+	// Eligibility is revoked by submitting a removal request for the same principal, role and scope
+	properties := client.UnifiedRoleEligibilityScheduleRequest{
+		Action:           utils.String(client.UnifiedRoleScheduleRequestActionAdminRemove),
+		DirectoryScopeId: utils.String(d.Get("directory_scope_id").(string)),
+		PrincipalId:      utils.String(principalId),
+		RoleDefinitionId: utils.String(roleId),
+	}
+
+	if v, ok := d.GetOk("justification"); ok {
+		properties.Justification = utils.String(v.(string))
+	}
+
+	if _, _, err := requestClient.Create(ctx, properties); err != nil {
+		if strings.Contains(err.Error(), "RoleAssignmentDoesNotExist") {
+			log.Printf("[DEBUG] Eligibility for principal %q and directory role %q already removed", principalId, roleId)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Could not revoke eligibility for principal %q and directory role %q", principalId, roleId)
+	}
+
+	return nil
+}
+
+// directoryRoleEligibilityScheduleExists returns true when an eligibility schedule exists for the specified principal,
+// directory role and directory scope.
+func directoryRoleEligibilityScheduleExists(ctx context.Context, scheduleClient *client.RoleEligibilityScheduleClient, principalId, roleId, directoryScopeId string) (bool, error) {
+	query := odata.Query{
+		Filter: fmt.Sprintf("principalId eq '%s' and roleDefinitionId eq '%s'", principalId, roleId),
+	}
+	schedules, _, err := scheduleClient.List(ctx, query)
+	if err != nil {
+		return false, fmt.Errorf("listing eligibility schedules with filter %q: %+v", query.Filter, err)
+	}
+
+	if schedules != nil {
+		for _, schedule := range *schedules {
+			if schedule.DirectoryScopeId != nil && *schedule.DirectoryScopeId == directoryScopeId {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,166 @@
+package directoryroles_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	directoryRolesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type DirectoryRoleEligibilityScheduleRequestResource struct{}
+
+func TestAccDirectoryRoleEligibilityScheduleRequest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_eligibility_schedule_request", "test")
+	r := DirectoryRoleEligibilityScheduleRequestResource{}
+
+	// The original request is retained after eligibility is revoked, so destroy checks are not possible
+	data.ResourceTestIgnoreDangling(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("directory_scope_id").HasValue("/"),
+				check.That(data.ResourceName).Key("start_date").Exists(),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleEligibilityScheduleRequest_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_eligibility_schedule_request", "test")
+	r := DirectoryRoleEligibilityScheduleRequestResource{}
+
+	data.ResourceTestIgnoreDangling(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("end_date").HasValue("2099-01-01T00:00:00Z"),
+				check.That(data.ResourceName).Key("justification").HasValue("Acceptance testing"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleEligibilityScheduleRequest_revokedOutOfBand(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_eligibility_schedule_request", "test")
+	r := DirectoryRoleEligibilityScheduleRequestResource{}
+	var principalId, roleId string
+
+	data.ResourceTestIgnoreDangling(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.captureRequest(data, &principalId, &roleId),
+			),
+		},
+		{
+			// The revoked eligibility should be detected, so that it is requested again
+			PreConfig:          r.revokeOutOfBand(t, &principalId, &roleId),
+			Config:             r.basic(data),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
+func (r DirectoryRoleEligibilityScheduleRequestResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.DirectoryRoles.RoleEligibilityScheduleRequestClient
+	client.BaseClient.DisableRetries = true
+
+	request, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Eligibility schedule request with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve eligibility schedule request with ID %q: %+v", state.ID, err)
+	}
+	return utils.Bool(request.ID != nil && *request.ID == state.ID), nil
+}
+
+// captureRequest records the principal and directory role of the eligibility schedule request in state, so that the
+// eligibility can be revoked out-of-band
+func (DirectoryRoleEligibilityScheduleRequestResource) captureRequest(data acceptance.TestData, principalId, roleId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		*principalId = rs.Primary.Attributes["principal_object_id"]
+		*roleId = rs.Primary.Attributes["role_id"]
+		return nil
+	}
+}
+
+func (DirectoryRoleEligibilityScheduleRequestResource) revokeOutOfBand(t *testing.T, principalId, roleId *string) func() {
+	return func() {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.DirectoryRoles.RoleEligibilityScheduleRequestClient
+
+		if _, _, err := client.Create(clients.StopContext, directoryRolesClient.UnifiedRoleEligibilityScheduleRequest{
+			Action:           utils.String(directoryRolesClient.UnifiedRoleScheduleRequestActionAdminRemove),
+			DirectoryScopeId: utils.String("/"),
+			PrincipalId:      principalId,
+			RoleDefinitionId: roleId,
+		}); err != nil {
+			t.Fatalf("revoking eligibility out-of-band for principal %q and directory role %q: %+v", *principalId, *roleId, err)
+		}
+	}
+}
+
+func (DirectoryRoleEligibilityScheduleRequestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Helpdesk Administrator"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r DirectoryRoleEligibilityScheduleRequestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_eligibility_schedule_request" "test" {
+  role_id             = azuread_directory_role.test.template_id
+  principal_object_id = azuread_user.test.object_id
+}
+`, r.template(data))
+}
+
+func (r DirectoryRoleEligibilityScheduleRequestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_eligibility_schedule_request" "test" {
+  role_id             = azuread_directory_role.test.template_id
+  principal_object_id = azuread_user.test.object_id
+  directory_scope_id  = "/"
+  justification       = "Acceptance testing"
+  end_date            = "2099-01-01T00:00:00Z"
+}
+`, r.template(data))
+}
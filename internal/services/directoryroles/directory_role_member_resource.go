@@ -46,7 +46,7 @@ func directoryRoleMemberResource() *schema.Resource {
 			"role_object_id": {
 				Description:      "The object ID of the directory role",
 				Type:             schema.TypeString,
-				Optional:         true,
+				Required:         true,
 				ForceNew:         true,
 				ValidateDiagFunc: validate.UUID,
 			},
@@ -54,7 +54,7 @@ func directoryRoleMemberResource() *schema.Resource {
 			"member_object_id": {
 				Description:      "The object ID of the member",
 				Type:             schema.TypeString,
-				Optional:         true,
+				Required:         true,
 				ForceNew:         true,
 				ValidateDiagFunc: validate.UUID,
 			},
@@ -74,9 +74,13 @@ func directoryRoleMemberResourceCreate(ctx context.Context, d *schema.ResourceDa
 	role, status, err := client.Get(ctx, id.DirectoryRoleId)
 	if err != nil {
 		if status == http.StatusNotFound {
-			return tf.ErrorDiagPathF(nil, "object_id", "Directory role with object ID %q was not found", id.DirectoryRoleId)
+			// A common mistake is to specify the template ID of a role, so check for this to return a helpful error
+			if activatedRole, _, err := client.GetByTemplateId(ctx, id.DirectoryRoleId); err == nil && activatedRole != nil && activatedRole.ID != nil {
+				return tf.ErrorDiagPathF(nil, "role_object_id", "The value %q is the template ID of a directory role, not its object ID. The object ID of the activated role is %q, which can be obtained from the `object_id` attribute of the `azuread_directory_role` resource", id.DirectoryRoleId, *activatedRole.ID)
+			}
+			return tf.ErrorDiagPathF(nil, "role_object_id", "Directory role with object ID %q was not found. Directory roles must be activated before members can be added, which can be done using the `azuread_directory_role` resource", id.DirectoryRoleId)
 		}
-		return tf.ErrorDiagPathF(err, "object_id", "Retrieving directory role with object ID: %q", id.DirectoryRoleId)
+		return tf.ErrorDiagPathF(err, "role_object_id", "Retrieving directory role with object ID: %q", id.DirectoryRoleId)
 	}
 
 	if _, status, err = client.GetMember(ctx, id.DirectoryRoleId, id.MemberId); err == nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -92,6 +93,35 @@ func TestAccDirectoryRoleMember_multipleUser(t *testing.T) {
 	})
 }
 
+func TestAccDirectoryRoleMember_globalReader(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
+	r := DirectoryRoleMemberResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.globalReader(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("member_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleMember_templateId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
+	r := DirectoryRoleMemberResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.templateId(data),
+			ExpectError: regexp.MustCompile("is the template ID of a directory role, not its object ID"),
+		},
+	})
+}
+
 func TestAccDirectoryRoleMember_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
 	r := DirectoryRoleMemberResource{}
@@ -211,3 +241,37 @@ resource "azuread_directory_role_member" "import" {
 }
 `, r.servicePrincipal(data))
 }
+
+func (r DirectoryRoleMemberResource) globalReader(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+%[1]s
+
+resource "azuread_directory_role" "test" {
+  template_id = "f2ef992c-3afb-46b9-b7cf-a126ee74c451" // Global reader
+}
+
+resource "azuread_directory_role_member" "test" {
+  role_object_id   = azuread_directory_role.test.object_id
+  member_object_id = azuread_user.testA.object_id
+}
+`, r.templateThreeUsers(data))
+}
+
+func (r DirectoryRoleMemberResource) templateId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+%[1]s
+
+resource "azuread_directory_role" "test" {
+  template_id = "f2ef992c-3afb-46b9-b7cf-a126ee74c451" // Global reader
+}
+
+resource "azuread_directory_role_member" "test" {
+  role_object_id   = azuread_directory_role.test.template_id
+  member_object_id = azuread_user.testA.object_id
+}
+`, r.templateThreeUsers(data))
+}
@@ -0,0 +1,149 @@
+package directoryroles
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func directoryRolesDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: directoryRolesDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_ids": {
+				Description: "A mapping of display names to object IDs for all activated directory roles",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"template_ids": {
+				Description: "The template IDs of all activated directory roles",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"roles": {
+				Description: "A list of activated directory roles",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Description: "The description of the directory role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the directory role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The object ID of the directory role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"template_id": {
+							Description: "The object ID of the template associated with the directory role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func directoryRolesDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRolesClient
+	client.BaseClient.DisableRetries = true
+
+	// The SDK follows any @odata.nextLink to retrieve all pages of results
+	directoryRoles, _, err := client.List(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve directory roles")
+	}
+	if directoryRoles == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil directoryRoles"), "Bad API Response")
+	}
+
+	sort.Slice(*directoryRoles, func(i, j int) bool {
+		var nameI, nameJ string
+		if v := (*directoryRoles)[i].DisplayName; v != nil {
+			nameI = *v
+		}
+		if v := (*directoryRoles)[j].DisplayName; v != nil {
+			nameJ = *v
+		}
+		return nameI < nameJ
+	})
+
+	objectIds := make(map[string]interface{})
+	roles := make([]interface{}, 0)
+	templateIds := make([]string, 0)
+
+	for _, r := range *directoryRoles {
+		if r.ID == nil || r.RoleTemplateId == nil {
+			return tf.ErrorDiagF(errors.New("API returned directory role with nil object ID or template ID"), "Bad API Response")
+		}
+
+		displayName := ""
+		if r.DisplayName != nil {
+			displayName = *r.DisplayName
+			objectIds[displayName] = *r.ID
+		}
+
+		description := ""
+		if r.Description != nil {
+			description = *r.Description
+		}
+
+		templateIds = append(templateIds, *r.RoleTemplateId)
+
+		roles = append(roles, map[string]interface{}{
+			"description":  description,
+			"display_name": displayName,
+			"object_id":    *r.ID,
+			"template_id":  *r.RoleTemplateId,
+		})
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(templateIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for directory role template IDs")
+	}
+
+	d.SetId(fmt.Sprintf("directoryRoles#%s", base64.URLEncoding.EncodeToString(h.Sum(nil))))
+
+	tf.Set(d, "object_ids", objectIds)
+	tf.Set(d, "roles", roles)
+	tf.Set(d, "template_ids", templateIds)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package directoryroles_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryRolesDataSource struct{}
+
+func TestAccDirectoryRolesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_roles", "test")
+	r := DirectoryRolesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("roles.#").Exists(),
+				check.That(data.ResourceName).Key("roles.0.display_name").Exists(),
+				check.That(data.ResourceName).Key("roles.0.object_id").IsUuid(),
+				check.That(data.ResourceName).Key("roles.0.template_id").IsUuid(),
+				check.That(data.ResourceName).Key("template_ids.#").Exists(),
+				check.That(data.ResourceName).Key("object_ids.Global Administrator").IsUuid(),
+			),
+		},
+	})
+}
+
+func (DirectoryRolesDataSource) basic() string {
+	return `
+provider "azuread" {}
+
+data "azuread_directory_roles" "test" {}
+`
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/manicminer/hamilton/odata"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
@@ -31,6 +32,13 @@ func domainsDataSource() *schema.Resource {
 				Optional:    true,
 			},
 
+			"authentication_type": {
+				Description:  "Only return domains with the specified authentication type, either `Managed` or `Federated`",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Managed", "Federated"}, false),
+			},
+
 			"include_unverified": {
 				Description:   "Set to `true` if unverified Azure AD domains should be included",
 				Type:          schema.TypeBool,
@@ -136,14 +144,27 @@ func domainsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta int
 	client.BaseClient.DisableRetries = true
 
 	adminManaged := d.Get("admin_managed").(bool)
+	authenticationType := d.Get("authentication_type").(string)
 	onlyDefault := d.Get("only_default").(bool)
 	onlyInitial := d.Get("only_initial").(bool)
 	onlyRoot := d.Get("only_root").(bool)
 	includeUnverified := d.Get("include_unverified").(bool)
 	supportsServices := d.Get("supports_services").([]interface{})
 
-	// OData filters are not supported for domains
-	result, _, err := client.List(ctx, odata.Query{})
+	// OData filters are not supported for domains, so results are filtered below, but we can at least limit the
+	// properties returned to those we're interested in
+	result, _, err := client.List(ctx, odata.Query{
+		Select: []string{
+			"authenticationType",
+			"id",
+			"isAdminManaged",
+			"isDefault",
+			"isInitial",
+			"isRoot",
+			"isVerified",
+			"supportedServices",
+		},
+	})
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not list domains")
 	}
@@ -155,6 +176,9 @@ func domainsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta int
 			if adminManaged && v.IsAdminManaged != nil && !*v.IsAdminManaged {
 				continue
 			}
+			if authenticationType != "" && (v.AuthenticationType == nil || !strings.EqualFold(*v.AuthenticationType, authenticationType)) {
+				continue
+			}
 			if onlyDefault && v.IsDefault != nil && !*v.IsDefault {
 				continue
 			}
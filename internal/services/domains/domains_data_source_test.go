@@ -1,6 +1,8 @@
 package domains_test
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -77,6 +79,41 @@ func TestAccDomainsDataSource_supportsServices(t *testing.T) {
 	})
 }
 
+func TestAccDomainsDataSource_authenticationTypeManaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_domains", "test")
+	r := DomainsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.authenticationType("Managed", false),
+			Check: r.testCheckFunc(data,
+				check.That(data.ResourceName).Key("domains.0.authentication_type").HasValue("Managed"),
+			),
+		},
+		{
+			Config: r.authenticationType("Managed", true),
+			Check: r.testCheckFunc(data,
+				check.That(data.ResourceName).Key("domains.#").HasValue("1"),
+				check.That(data.ResourceName).Key("domains.0.authentication_type").HasValue("Managed"),
+				check.That(data.ResourceName).Key("domains.0.initial").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccDomainsDataSource_authenticationTypeFederated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_domains", "test")
+	r := DomainsDataSource{}
+
+	// The initial domain is always managed, so combining these filters should never match a domain
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config:      r.authenticationType("Federated", true),
+			ExpectError: regexp.MustCompile("No domains found for the provided filters"),
+		},
+	})
+}
+
 func (DomainsDataSource) testCheckFunc(data acceptance.TestData, additionalChecks ...resource.TestCheckFunc) resource.TestCheckFunc {
 	checks := []resource.TestCheckFunc{
 		check.That(data.ResourceName).Key("domains.0.domain_name").Exists(),
@@ -95,6 +132,15 @@ func (DomainsDataSource) basic() string {
 	return `data "azuread_domains" "test" {}`
 }
 
+func (DomainsDataSource) authenticationType(authenticationType string, onlyInitial bool) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  authentication_type = "%[1]s"
+  only_initial        = %[2]t
+}
+`, authenticationType, onlyInitial)
+}
+
 func (DomainsDataSource) onlyDefault() string {
 	return `
 data "azuread_domains" "test" {
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+// GroupAdministrativeUnitsClient lists the administrative units of which a group is a member, which is not yet
+// supported by the Hamilton SDK.
+type GroupAdministrativeUnitsClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewGroupAdministrativeUnitsClient(tenantId string) *GroupAdministrativeUnitsClient {
+	return &GroupAdministrativeUnitsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// List returns the object IDs of the administrative units of which a group is a member.
+func (c *GroupAdministrativeUnitsClient) List(ctx context.Context, groupId string) (*[]string, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  odata.Query{Select: []string{"id"}},
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s/memberOf/microsoft.graph.administrativeUnit", groupId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupAdministrativeUnitsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		AdministrativeUnits []struct {
+			Id string `json:"id"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	ret := make([]string, len(data.AdministrativeUnits))
+	for i, v := range data.AdministrativeUnits {
+		ret[i] = v.Id
+	}
+
+	return &ret, status, nil
+}
@@ -7,19 +7,34 @@ import (
 )
 
 type Client struct {
-	DirectoryObjectsClient *msgraph.DirectoryObjectsClient
-	GroupsClient           *msgraph.GroupsClient
+	AdministrativeUnitsClient      *msgraph.AdministrativeUnitsClient
+	DirectoryObjectsClient         *msgraph.DirectoryObjectsClient
+	GroupAdministrativeUnitsClient *GroupAdministrativeUnitsClient
+	GroupsClient                   *msgraph.GroupsClient
+	GroupWritebackClient           *GroupWritebackClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	administrativeUnitsClient := msgraph.NewAdministrativeUnitsClient(o.TenantID)
+	o.ConfigureClient(&administrativeUnitsClient.BaseClient)
+
 	directoryObjectsClient := msgraph.NewDirectoryObjectsClient(o.TenantID)
 	o.ConfigureClient(&directoryObjectsClient.BaseClient)
 
+	groupAdministrativeUnitsClient := NewGroupAdministrativeUnitsClient(o.TenantID)
+	o.ConfigureClient(&groupAdministrativeUnitsClient.BaseClient)
+
 	groupsClient := msgraph.NewGroupsClient(o.TenantID)
 	o.ConfigureClient(&groupsClient.BaseClient)
 
+	groupWritebackClient := NewGroupWritebackClient(o.TenantID)
+	o.ConfigureClient(&groupWritebackClient.BaseClient)
+
 	return &Client{
-		DirectoryObjectsClient: directoryObjectsClient,
-		GroupsClient:           groupsClient,
+		AdministrativeUnitsClient:      administrativeUnitsClient,
+		DirectoryObjectsClient:         directoryObjectsClient,
+		GroupAdministrativeUnitsClient: groupAdministrativeUnitsClient,
+		GroupsClient:                   groupsClient,
+		GroupWritebackClient:           groupWritebackClient,
 	}
 }
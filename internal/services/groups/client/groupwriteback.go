@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+const (
+	OnPremisesGroupTypeUniversalDistributionGroup        = "UniversalDistributionGroup"
+	OnPremisesGroupTypeUniversalMailEnabledSecurityGroup = "UniversalMailEnabledSecurityGroup"
+	OnPremisesGroupTypeUniversalSecurityGroup            = "UniversalSecurityGroup"
+)
+
+// GroupWritebackConfiguration describes whether and how a group is written back to an on-premises Active Directory
+// when Azure AD Connect group writeback is configured. This is not yet modelled by the Hamilton SDK.
+type GroupWritebackConfiguration struct {
+	IsEnabled           *bool   `json:"isEnabled,omitempty"`
+	OnPremisesGroupType *string `json:"onPremisesGroupType,omitempty"`
+}
+
+type groupWriteback struct {
+	WritebackConfiguration *GroupWritebackConfiguration `json:"writebackConfiguration,omitempty"`
+}
+
+type GroupWritebackClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewGroupWritebackClient(tenantId string) *GroupWritebackClient {
+	return &GroupWritebackClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta, tenantId),
+	}
+}
+
+// Get retrieves the writeback configuration for a group.
+func (c *GroupWritebackClient) Get(ctx context.Context, id string) (*GroupWritebackConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  odata.Query{Select: []string{"writebackConfiguration"}},
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupWritebackClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var group groupWriteback
+	if err := json.Unmarshal(respBody, &group); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	if group.WritebackConfiguration == nil {
+		return &GroupWritebackConfiguration{}, status, nil
+	}
+
+	return group.WritebackConfiguration, status, nil
+}
+
+// Update amends the writeback configuration for a group.
+func (c *GroupWritebackClient) Update(ctx context.Context, id string, config GroupWritebackConfiguration) (int, error) {
+	var status int
+
+	body, err := json.Marshal(groupWriteback{WritebackConfiguration: &config})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("GroupWritebackClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
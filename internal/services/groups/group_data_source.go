@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
@@ -45,6 +46,13 @@ func groupDataSource() *schema.Resource {
 				ValidateDiagFunc: validate.UUID,
 			},
 
+			"include_transitive_members": {
+				Description: "Specifies whether to include transitive members (a flat list of all nested members)",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"mail_enabled": {
 				Description: "Whether the group is mail-enabled",
 				Type:        schema.TypeBool,
@@ -74,6 +82,12 @@ func groupDataSource() *schema.Resource {
 				},
 			},
 
+			"classification": {
+				Description: "The data sensitivity classification for the group",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
 			"description": {
 				Description: "The optional description of the group",
 				Type:        schema.TypeString,
@@ -112,6 +126,12 @@ func groupDataSource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"member_count": {
+				Description: "The number of direct members of the group",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"members": {
 				Description: "The object IDs of the group members",
 				Type:        schema.TypeList,
@@ -127,6 +147,12 @@ func groupDataSource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"onpremises_group_type": {
+				Description: "Indicates the target on-premise group type the group will be written back as",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
 			"onpremises_netbios_name": {
 				Description: "The on-premises NetBIOS name, synchronized from the on-premises directory when Azure AD Connect is used",
 				Type:        schema.TypeString,
@@ -160,6 +186,12 @@ func groupDataSource() *schema.Resource {
 				},
 			},
 
+			"owner_count": {
+				Description: "The number of owners of the group",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"preferred_language": {
 				Description: "The preferred language for a Microsoft 365 group, in ISO 639-1 notation",
 				Type:        schema.TypeString,
@@ -190,6 +222,12 @@ func groupDataSource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"transitive_member_count": {
+				Description: "The number of members of the group, including members of nested groups",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"types": {
 				Description: "A list of group types configured for the group. The only supported type is `Unified`, which specifies a Microsoft 365 group",
 				Type:        schema.TypeList,
@@ -204,12 +242,19 @@ func groupDataSource() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+
+			"writeback_enabled": {
+				Description: "Whether this group is synced from Azure AD to the on-premises directory when Azure AD Connect is used",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 		},
 	}
 }
 
 func groupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.GroupsClient
+	writebackClient := meta.(*clients.Client).Groups.GroupWritebackClient
 	client.BaseClient.DisableRetries = true
 
 	var group msgraph.Group
@@ -292,6 +337,7 @@ func groupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	tf.Set(d, "assignable_to_role", group.IsAssignableToRole)
 	tf.Set(d, "behaviors", tf.FlattenStringSlice(group.ResourceBehaviorOptions))
+	tf.Set(d, "classification", group.Classification)
 	tf.Set(d, "description", group.Description)
 	tf.Set(d, "display_name", group.DisplayName)
 	tf.Set(d, "mail", group.Mail)
@@ -324,17 +370,53 @@ func groupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	tf.Set(d, "dynamic_membership", dynamicMembership)
 
-	members, _, err := client.ListMembers(ctx, d.Id())
-	if err != nil {
-		return tf.ErrorDiagF(err, "Could not retrieve group members for group with object ID: %q", d.Id())
+	var members *[]string
+	var err error
+	if d.Get("include_transitive_members").(bool) {
+		members, _, err = groupListTransitiveMembers(ctx, client, d.Id())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve transitive group members for group with object ID: %q", d.Id())
+		}
+	} else {
+		members, _, err = client.ListMembers(ctx, d.Id())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve group members for group with object ID: %q", d.Id())
+		}
 	}
 	tf.Set(d, "members", members)
 
+	// Writeback configuration is retrieved from the beta API and may not be readable in all tenants
+	if writeback, _, err := writebackClient.Get(ctx, d.Id()); err != nil {
+		log.Printf("[DEBUG] Unable to read writeback configuration for group with ID %q: %v", d.Id(), err)
+	} else {
+		tf.Set(d, "onpremises_group_type", writeback.OnPremisesGroupType)
+		tf.Set(d, "writeback_enabled", writeback.IsEnabled != nil && *writeback.IsEnabled)
+	}
+
 	owners, _, err := client.ListOwners(ctx, d.Id())
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not retrieve group owners for group with object ID: %q", d.Id())
 	}
 	tf.Set(d, "owners", owners)
 
+	// When transitive members are included, direct members will be listed separately if they cannot be counted
+	directMembers := members
+	if d.Get("include_transitive_members").(bool) {
+		directMembers = nil
+	}
+	memberCount, ownerCount, err := groupCounts(ctx, client, d.Id(), directMembers, owners)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not count members and owners for group with object ID %q", d.Id())
+	}
+	tf.Set(d, "member_count", memberCount)
+	tf.Set(d, "owner_count", ownerCount)
+
+	// Transitive members may not be readable with the same permissions as direct members
+	if transitiveMemberCount, err := groupTransitiveMemberCount(ctx, client, d.Id()); err != nil {
+		log.Printf("[DEBUG] Unable to count transitive members for group with ID %q: %v", d.Id(), err)
+	} else {
+		tf.Set(d, "transitive_member_count", transitiveMemberCount)
+	}
+
 	return nil
 }
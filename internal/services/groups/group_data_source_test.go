@@ -106,6 +106,29 @@ func TestAccGroupDataSource_members(t *testing.T) {
 	})
 }
 
+func TestAccGroupDataSource_transitiveMembers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: GroupDataSource{}.transitiveMembers(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("members.#").HasValue("2"),
+				check.That(data.ResourceName).Key("member_count").HasValue("2"),
+				check.That(data.ResourceName).Key("transitive_member_count").HasValue("4"),
+			),
+		},
+		{
+			Config: GroupDataSource{}.transitiveMembers(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("members.#").HasValue("4"),
+				check.That(data.ResourceName).Key("member_count").HasValue("2"),
+				check.That(data.ResourceName).Key("transitive_member_count").HasValue("4"),
+			),
+		},
+	})
+}
+
 func TestAccGroupDataSource_owners(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_group", "test")
 
@@ -202,3 +225,32 @@ data "azuread_group" "test" {
 }
 `, GroupResource{}.withThreeOwners(data))
 }
+
+func (GroupDataSource) transitiveMembers(data acceptance.TestData, transitive bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "nested" {
+  display_name     = "acctestGroup-%[2]d-Nested"
+  security_enabled = true
+  members = [
+    azuread_user.testB.object_id,
+    azuread_user.testC.object_id,
+  ]
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[2]d"
+  security_enabled = true
+  members = [
+    azuread_user.testA.object_id,
+    azuread_group.nested.object_id,
+  ]
+}
+
+data "azuread_group" "test" {
+  object_id                  = azuread_group.test.object_id
+  include_transitive_members = %[3]t
+}
+`, GroupResource{}.templateThreeUsers(data), data.RandomInteger, transitive)
+}
@@ -78,6 +78,9 @@ func groupMemberResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 		return tf.ErrorDiagPathF(err, "object_id", "Retrieving group with object ID: %q", groupId)
 	}
+	if err := groupValidateMembersManageable(groupId, group.OnPremisesSyncEnabled); err != nil {
+		return tf.ErrorDiagPathF(err, "group_object_id", "Could not add member %q to group", memberId)
+	}
 
 	existingMembers, _, err := client.ListMembers(ctx, id.GroupId)
 	if err != nil {
@@ -0,0 +1,209 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func groupOwnerResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: groupOwnerResourceCreate,
+		ReadContext:   groupOwnerResourceRead,
+		DeleteContext: groupOwnerResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.GroupOwnerID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"group_object_id": {
+				Description:      "The object ID of the group you want to add the owner to",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"owner_object_id": {
+				Description:      "The object ID of the principal you want to add as an owner of the group. Supported object types are Users or Service Principals",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func groupOwnerResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupsClient
+	directoryObjectsClient := meta.(*clients.Client).Groups.DirectoryObjectsClient
+	groupId := d.Get("group_object_id").(string)
+	ownerId := d.Get("owner_object_id").(string)
+
+	id := parse.NewGroupOwnerID(groupId, ownerId)
+
+	tf.LockByName(groupResourceName, id.GroupId)
+	defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+	group, status, err := client.Get(ctx, groupId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "Group with object ID %q was not found", groupId)
+		}
+		return tf.ErrorDiagPathF(err, "group_object_id", "Retrieving group with object ID: %q", groupId)
+	}
+
+	existingOwners, _, err := client.ListOwners(ctx, id.GroupId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing existing owners for group with object ID: %q", id.GroupId)
+	}
+	if existingOwners != nil {
+		for _, v := range *existingOwners {
+			if strings.EqualFold(v, ownerId) {
+				return tf.ImportAsExistsDiag("azuread_group_owner", id.String())
+			}
+		}
+	}
+
+	ownerObject, _, err := directoryObjectsClient.Get(ctx, ownerId, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve principal object %q", ownerId)
+	}
+	if ownerObject == nil {
+		return tf.ErrorDiagF(errors.New("returned ownerObject was nil"), "Could not retrieve owner principal object %q", ownerId)
+	}
+	// TODO: remove this workaround for https://github.com/hashicorp/terraform-provider-azuread/issues/588
+	ownerObject.ODataId = (*odata.Id)(utils.String(fmt.Sprintf("%s/v1.0/%s/directoryObjects/%s",
+		client.BaseClient.Endpoint, client.BaseClient.TenantId, ownerId)))
+
+	group.Owners = &msgraph.Owners{*ownerObject}
+
+	if _, err := client.AddOwners(ctx, group); err != nil {
+		return tf.ErrorDiagF(err, "Adding group owner %q to group %q", ownerId, groupId)
+	}
+
+	d.SetId(id.String())
+
+	// Wait for the new owner to be consistently reflected, since it is read back immediately
+	if err := helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		client.BaseClient.DisableRetries = true
+		if _, status, err := client.GetOwner(ctx, id.GroupId, id.OwnerId); err != nil {
+			if status == http.StatusNotFound {
+				return utils.Bool(false), nil
+			}
+			return nil, err
+		}
+		return utils.Bool(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for owner %q of group with object ID %q to be added", ownerId, id.GroupId)
+	}
+
+	return groupOwnerResourceRead(ctx, d, meta)
+}
+
+func groupOwnerResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupsClient
+
+	id, err := parse.GroupOwnerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Owner ID %q", d.Id())
+	}
+
+	owners, status, err := client.ListOwners(ctx, id.GroupId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Group with ID %q was not found - removing owner %q from state", id.GroupId, id.OwnerId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving owners for group with object ID: %q", id.GroupId)
+	}
+
+	var ownerObjectId string
+	if owners != nil {
+		for _, objectId := range *owners {
+			if strings.EqualFold(objectId, id.OwnerId) {
+				ownerObjectId = objectId
+				break
+			}
+		}
+	}
+
+	if ownerObjectId == "" {
+		log.Printf("[DEBUG] Owner with ID %q was not found in Group %q - removing from state", id.OwnerId, id.GroupId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "group_object_id", id.GroupId)
+	tf.Set(d, "owner_object_id", ownerObjectId)
+
+	return nil
+}
+
+func groupOwnerResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupsClient
+
+	id, err := parse.GroupOwnerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Owner ID %q", d.Id())
+	}
+
+	tf.LockByName(groupResourceName, id.GroupId)
+	defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+	// Groups must always have at least one owner, so the API will refuse to remove the last remaining owner
+	owners, _, err := client.ListOwners(ctx, id.GroupId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving owners for group with object ID: %q", id.GroupId)
+	}
+	if owners != nil && len(*owners) == 1 && strings.EqualFold((*owners)[0], id.OwnerId) {
+		return tf.ErrorDiagF(errors.New("a group must have at least one owner"),
+			"Cannot remove owner %q from group with object ID %q as it is the last remaining owner. Add another owner to the group before removing this one", id.OwnerId, id.GroupId)
+	}
+
+	if _, err := client.RemoveOwners(ctx, id.GroupId, &[]string{id.OwnerId}); err != nil {
+		return tf.ErrorDiagF(err, "Removing owner %q from group with object ID: %q", id.OwnerId, id.GroupId)
+	}
+
+	// Wait for ownership link to be deleted
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		client.BaseClient.DisableRetries = true
+		if _, status, err := client.GetOwner(ctx, id.GroupId, id.OwnerId); err != nil {
+			if status == http.StatusNotFound {
+				return utils.Bool(false), nil
+			}
+			return nil, err
+		}
+		return utils.Bool(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of owner %q from group with object ID %q", id.OwnerId, id.GroupId)
+	}
+
+	return nil
+}
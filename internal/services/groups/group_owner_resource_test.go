@@ -0,0 +1,170 @@
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type GroupOwnerResource struct{}
+
+func TestAccGroupOwner_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_owner", "test")
+	r := GroupOwnerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.user(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("owner_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.template(data),
+		},
+		// we rerun the config so the group resource updates with the number of owners
+		{
+			Config: r.template(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("azuread_group.test").Key("owners.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccGroupOwner_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_owner", "test")
+	r := GroupOwnerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("owner_object_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupOwner_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_owner", "test")
+	r := GroupOwnerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.user(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r GroupOwnerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupsClient
+	client.BaseClient.DisableRetries = true
+
+	id, err := parse.GroupOwnerID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Group Owner ID: %v", err)
+	}
+
+	owners, _, err := client.ListOwners(ctx, id.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve Group owners (groupId: %q): %+v", id.GroupId, err)
+	}
+
+	if owners != nil {
+		for _, objectId := range *owners {
+			if strings.EqualFold(objectId, id.OwnerId) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Owner %q was not found in Group %q", id.OwnerId, id.GroupId)
+}
+
+func (GroupOwnerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_client_config" "test" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  owners           = [data.azuread_client_config.test.object_id]
+  security_enabled = true
+
+  lifecycle {
+    ignore_changes = [owners]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r GroupOwnerResource) user(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+}
+
+resource "azuread_group_owner" "test" {
+  group_object_id = azuread_group.test.object_id
+  owner_object_id = azuread_user.test.object_id
+}
+`, r.template(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (r GroupOwnerResource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[2]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_group_owner" "test" {
+  group_object_id = azuread_group.test.object_id
+  owner_object_id = azuread_service_principal.test.object_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GroupOwnerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_owner" "import" {
+  group_object_id = azuread_group_owner.test.group_object_id
+  owner_object_id = azuread_group_owner.test.owner_object_id
+}
+`, r.user(data))
+}
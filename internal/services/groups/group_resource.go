@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	groupsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
@@ -56,6 +58,18 @@ func groupResource() *schema.Resource {
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
+			"administrative_unit_ids": {
+				Description: "The object IDs of administrative units in which the group is a member",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Set:         schema.HashString,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+
 			"assignable_to_role": {
 				Description: "Indicates whether this group can be assigned to an Azure Active Directory role. This property can only be `true` for security-enabled groups.",
 				Type:        schema.TypeBool,
@@ -63,6 +77,13 @@ func groupResource() *schema.Resource {
 				ForceNew:    true,
 			},
 
+			"auto_subscribe_new_members": {
+				Description: "Indicates whether new members added to the group will be auto-subscribed to receive email notifications. Only set for Unified groups",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
 			"behaviors": {
 				Description: "The group behaviours for a Microsoft 365 group",
 				Type:        schema.TypeSet,
@@ -79,6 +100,14 @@ func groupResource() *schema.Resource {
 				},
 			},
 
+			"classification": {
+				Description:      "The data sensitivity classification for the group, which must be one of the classifications configured for the tenant",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
 			"description": {
 				Description: "The description for the group",
 				Type:        schema.TypeString,
@@ -108,6 +137,20 @@ func groupResource() *schema.Resource {
 				},
 			},
 
+			"hide_from_address_lists": {
+				Description: "Indicates whether the group is displayed in certain parts of the Outlook user interface: in the Address Book, in address lists for selecting message recipients, and in the Browse Groups dialog for searching groups. Only set for Unified groups",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"hide_from_outlook_clients": {
+				Description: "Indicates whether the group is displayed in Outlook clients, such as Outlook for Windows and Outlook on the web. Only set for Unified groups",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
 			"mail_enabled": {
 				Description:  "Whether the group is a mail enabled, with a shared group mailbox. At least one of `mail_enabled` or `security_enabled` must be specified. A group can be mail enabled _and_ security enabled",
 				Type:         schema.TypeBool,
@@ -137,6 +180,13 @@ func groupResource() *schema.Resource {
 				},
 			},
 
+			"ignore_missing_members": {
+				Description: "If `true`, members which no longer exist will be ignored when adding members to the group, instead of returning an error",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"owners": {
 				Description: "A set of owners who own this group. Supported object types are Users or Service Principals",
 				Type:        schema.TypeSet,
@@ -220,12 +270,25 @@ func groupResource() *schema.Resource {
 				}, false),
 			},
 
+			"writeback_enabled": {
+				Description: "Whether this group should be synced from Azure AD to the on-premises directory when Azure AD Connect is used",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"mail": {
 				Description: "The SMTP address for the group",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
 
+			"member_count": {
+				Description: "The number of direct members of the group",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"object_id": {
 				Description: "The object ID of the group",
 				Type:        schema.TypeString,
@@ -238,6 +301,18 @@ func groupResource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"onpremises_group_type": {
+				Description: "Indicates the target on-premise group type the group will be written back as",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					groupsClient.OnPremisesGroupTypeUniversalDistributionGroup,
+					groupsClient.OnPremisesGroupTypeUniversalMailEnabledSecurityGroup,
+					groupsClient.OnPremisesGroupTypeUniversalSecurityGroup,
+				}, false),
+			},
+
 			"onpremises_netbios_name": {
 				Description: "The on-premises NetBIOS name, synchronized from the on-premises directory when Azure AD Connect is used",
 				Type:        schema.TypeString,
@@ -262,6 +337,12 @@ func groupResource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"owner_count": {
+				Description: "The number of owners of the group",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"preferred_language": {
 				Description: "The preferred language for a Microsoft 365 group, in ISO 639-1 notation",
 				Type:        schema.TypeString,
@@ -276,6 +357,12 @@ func groupResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"transitive_member_count": {
+				Description: "The number of members of the group, including members of nested groups",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -296,13 +383,33 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 				if existingGroup.ID == nil {
 					return fmt.Errorf("API error: group returned with nil object ID during duplicate name check")
 				}
-				if diff.Id() == "" || diff.Id() == *existingGroup.ID {
+				if *existingGroup.ID != diff.Id() {
 					return tf.ImportAsDuplicateError("azuread_group", *existingGroup.ID, newDisplayName.(string))
 				}
 			}
 		}
 	}
 
+	// Mail nicknames must additionally be unique amongst mail-enabled groups
+	oldMailNickname, newMailNickname := diff.GetChange("mail_nickname")
+	if diff.Get("prevent_duplicate_names").(bool) && diff.Get("mail_enabled").(bool) && tf.ValueIsNotEmptyOrUnknown(newMailNickname) &&
+		(oldMailNickname.(string) == "" || !strings.EqualFold(oldMailNickname.(string), newMailNickname.(string))) {
+		result, err := groupFindByMailNickname(ctx, client, newMailNickname.(string))
+		if err != nil {
+			return fmt.Errorf("could not check for existing group(s): %+v", err)
+		}
+		if result != nil && len(*result) > 0 {
+			for _, existingGroup := range *result {
+				if existingGroup.ID == nil {
+					return fmt.Errorf("API error: group returned with nil object ID during duplicate mail nickname check")
+				}
+				if *existingGroup.ID != diff.Id() {
+					return tf.ImportAsDuplicateError("azuread_group", *existingGroup.ID, newMailNickname.(string))
+				}
+			}
+		}
+	}
+
 	mailEnabled := diff.Get("mail_enabled").(bool)
 	securityEnabled := diff.Get("security_enabled").(bool)
 	groupTypes := make([]msgraph.GroupType, 0)
@@ -310,24 +417,15 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 		groupTypes = append(groupTypes, v.(string))
 	}
 
-	hasGroupType := func(value msgraph.GroupType) bool {
-		for _, v := range groupTypes {
-			if value == v {
-				return true
-			}
-		}
-		return false
-	}
-
-	if hasGroupType(msgraph.GroupTypeDynamicMembership) && diff.Get("dynamic_membership.#").(int) == 0 {
+	if hasGroupType(groupTypes, msgraph.GroupTypeDynamicMembership) && diff.Get("dynamic_membership.#").(int) == 0 {
 		return fmt.Errorf("`dynamic_membership` must be specified when `types` contains %q", msgraph.GroupTypeDynamicMembership)
 	}
 
-	if mailEnabled && !hasGroupType(msgraph.GroupTypeUnified) {
+	if mailEnabled && !hasGroupType(groupTypes, msgraph.GroupTypeUnified) {
 		return fmt.Errorf("`types` must contain %q for mail-enabled groups", msgraph.GroupTypeUnified)
 	}
 
-	if !mailEnabled && hasGroupType(msgraph.GroupTypeUnified) {
+	if !mailEnabled && hasGroupType(groupTypes, msgraph.GroupTypeUnified) {
 		return fmt.Errorf("`mail_enabled` must be true for unified groups")
 	}
 
@@ -335,13 +433,18 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 		return fmt.Errorf("`mail_nickname` is required for mail-enabled groups")
 	}
 
-	if diff.Get("assignable_to_role").(bool) && !securityEnabled {
-		return fmt.Errorf("`assignable_to_role` can only be `true` for security-enabled groups")
+	if diff.Get("assignable_to_role").(bool) {
+		if !securityEnabled {
+			return fmt.Errorf("`assignable_to_role` can only be `true` for security-enabled groups")
+		}
+		if hasGroupType(groupTypes, msgraph.GroupTypeDynamicMembership) {
+			return fmt.Errorf("`assignable_to_role` cannot be `true` when `types` contains %q, since role-assignable groups do not support dynamic membership", msgraph.GroupTypeDynamicMembership)
+		}
 	}
 
 	visibilityOld, visibilityNew := diff.GetChange("visibility")
 
-	if !hasGroupType(msgraph.GroupTypeUnified) {
+	if !hasGroupType(groupTypes, msgraph.GroupTypeUnified) {
 		if behaviors, ok := diff.GetOk("behaviors"); ok && len(behaviors.(*schema.Set).List()) > 0 {
 			return fmt.Errorf("`behaviors` is only supported for unified groups")
 		}
@@ -354,13 +457,36 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 			return fmt.Errorf("`theme` is only supported for unified groups")
 		}
 
+		for _, field := range []string{"auto_subscribe_new_members", "hide_from_address_lists", "hide_from_outlook_clients"} {
+			if v, ok := diff.GetOk(field); ok && v.(bool) {
+				return fmt.Errorf("`%s` is only supported for unified groups", field)
+			}
+		}
+
 		if visibilityNew.(string) == msgraph.GroupVisibilityHiddenMembership {
 			return fmt.Errorf("`visibility` can only be %q for unified groups", msgraph.GroupVisibilityHiddenMembership)
 		}
 	}
 
-	if (visibilityOld.(string) == msgraph.GroupVisibilityPrivate || visibilityOld.(string) == msgraph.GroupVisibilityPublic) &&
-		visibilityNew.(string) == msgraph.GroupVisibilityHiddenMembership {
+	// Security groups can only be written back as security groups, whereas Microsoft 365 groups can be written back as
+	// distribution groups, mail-enabled security groups or security groups
+	if onPremisesGroupType := diff.Get("onpremises_group_type").(string); diff.Get("writeback_enabled").(bool) && onPremisesGroupType != "" &&
+		!hasGroupType(groupTypes, msgraph.GroupTypeUnified) && onPremisesGroupType != groupsClient.OnPremisesGroupTypeUniversalSecurityGroup {
+		return fmt.Errorf("`onpremises_group_type` must be %q for security groups", groupsClient.OnPremisesGroupTypeUniversalSecurityGroup)
+	}
+
+	// Members of groups synchronized from on-premises cannot be changed in Azure AD, so catch this before the API rejects it
+	if diff.Id() != "" && diff.HasChange("members") {
+		if err := groupValidateMembersManageable(diff.Id(), utils.Bool(diff.Get("onpremises_sync_enabled").(bool))); err != nil {
+			return err
+		}
+	}
+
+	// Hidden membership can only be set when a group is created, and cannot be removed afterwards, whereas the
+	// Private and Public visibilities can be switched between in place
+	if diff.Id() != "" && tf.ValueIsNotEmptyOrUnknown(visibilityNew) && visibilityOld.(string) != visibilityNew.(string) &&
+		(strings.EqualFold(visibilityOld.(string), msgraph.GroupVisibilityHiddenMembership) ||
+			strings.EqualFold(visibilityNew.(string), msgraph.GroupVisibilityHiddenMembership)) {
 		diff.ForceNew("visibility")
 	}
 
@@ -369,7 +495,11 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 
 func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.GroupsClient
+	writebackClient := meta.(*clients.Client).Groups.GroupWritebackClient
 	directoryObjectsClient := meta.(*clients.Client).Groups.DirectoryObjectsClient
+	if err := meta.(*clients.Client).LoadClaims(); err != nil {
+		return tf.ErrorDiagF(err, "Could not obtain claims for the authenticated principal")
+	}
 	callerId := meta.(*clients.Client).Claims.ObjectId
 
 	displayName := d.Get("display_name").(string)
@@ -378,7 +508,7 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.Get("prevent_duplicate_names").(bool) {
 		result, err := groupFindByName(ctx, client, displayName)
 		if err != nil {
-			return tf.ErrorDiagPathF(err, "name", "Could not check for existing groups(s)")
+			return tf.ErrorDiagPathF(err, "display_name", "Could not check for existing groups(s)")
 		}
 		if result != nil && len(*result) > 0 {
 			existingGroup := (*result)[0]
@@ -387,6 +517,20 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 			return tf.ImportAsDuplicateDiag("azuread_group", *existingGroup.ID, displayName)
 		}
+
+		if mailNickname := d.Get("mail_nickname").(string); d.Get("mail_enabled").(bool) && mailNickname != "" {
+			result, err := groupFindByMailNickname(ctx, client, mailNickname)
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "mail_nickname", "Could not check for existing groups(s)")
+			}
+			if result != nil && len(*result) > 0 {
+				existingGroup := (*result)[0]
+				if existingGroup.ID == nil {
+					return tf.ErrorDiagF(errors.New("API returned group with nil object ID during duplicate mail nickname check"), "Bad API response")
+				}
+				return tf.ImportAsDuplicateDiag("azuread_group", *existingGroup.ID, mailNickname)
+			}
+		}
 	}
 
 	groupTypes := make([]msgraph.GroupType, 0)
@@ -397,10 +541,13 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	mailEnabled := d.Get("mail_enabled").(bool)
 	securityEnabled := d.Get("security_enabled").(bool)
 
-	// Mimic the portal and generate a random mailNickname for security groups
-	mailNickname := groupDefaultMailNickname()
+	// When not specified, derive the mailNickname from the display name, falling back to a random value when the
+	// display name contains no usable characters. This is only possible for groups that are not mail-enabled.
+	mailNickname := groupDerivedMailNickname(displayName)
+	mailNicknameDerived := true
 	if v, ok := d.GetOk("mail_nickname"); ok && v.(string) != "" {
 		mailNickname = v.(string)
+		mailNicknameDerived = false
 	}
 
 	behaviorOptions := make([]msgraph.GroupResourceBehaviorOption, 0)
@@ -443,6 +590,10 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		properties.MembershipRule = utils.NullableString(d.Get("dynamic_membership.0.rule").(string))
 	}
 
+	if classification := d.Get("classification").(string); classification != "" {
+		properties.Classification = utils.String(classification)
+	}
+
 	if theme := d.Get("theme").(string); theme != "" {
 		properties.Theme = utils.NullableString(theme)
 	}
@@ -522,6 +673,7 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	var diags diag.Diagnostics
 	if len(ownersFirst20) == 0 {
 		// The calling principal is the default owner if no others are specified. This is the default API behaviour, so
 		// we're being explicit about this in order to minimise confusion and avoid inconsistent API behaviours.
@@ -530,14 +682,38 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			return tf.ErrorDiagF(err, "Could not retrieve calling principal object %q", callerId)
 		}
 		ownersFirst20 = msgraph.Owners{*callerObject}
+
+		if hasGroupType(groupTypes, msgraph.GroupTypeUnified) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "No owners were specified for Microsoft 365 group",
+				Detail:        fmt.Sprintf("Microsoft 365 groups must have at least one owner, so the authenticated principal (object ID: %q) has been assigned as the owner of group %q. Specify `owners` to avoid this warning.", callerId, displayName),
+				AttributePath: cty.Path{cty.GetAttrStep{Name: "owners"}},
+			})
+		}
 	}
 
 	// Set the initial owners, which either be the calling principal, or up to 20 of the owners specified in configuration
 	properties.Owners = &ownersFirst20
 
 	group, _, err := client.Create(ctx, properties)
+	if err != nil && mailNicknameDerived && helpers.IsMailNicknameConflict(err) {
+		// The derived mailNickname is already in use, so mimic the portal and try again with a random value
+		mailNickname = groupDefaultMailNickname()
+		properties.MailNickname = utils.String(mailNickname)
+		group, _, err = client.Create(ctx, properties)
+	}
 	if err != nil {
-		return tf.ErrorDiagF(err, "Creating group %q", displayName)
+		if helpers.IsMailNicknameConflict(err) {
+			return helpers.MailNicknameConflictDiag(err, groupResourceName, mailNickname)
+		}
+		if groupIsClassificationError(err) {
+			return groupClassificationErrorDiag(err, d.Get("classification").(string))
+		}
+		if hasGroupType(groupTypes, msgraph.GroupTypeUnified) && !groupHasUserOwner(ownersFirst20) {
+			return groupUnifiedOwnersErrorDiag(err, displayName)
+		}
+		return tf.ODataErrorDiagF(err, "Creating group %q", displayName)
 	}
 
 	if group.ID == nil {
@@ -558,7 +734,25 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagF(err, "Timed out whilst waiting for new group to be replicated in Azure AD")
 		}
-		return tf.ErrorDiagF(err, "Failed to patch group after creating")
+		return tf.ODataErrorDiagF(err, "Failed to patch group after creating")
+	}
+
+	// The Exchange-backed settings for Microsoft 365 groups cannot be specified when creating the group, and must be
+	// patched in a separate request
+	if hasGroupType(groupTypes, msgraph.GroupTypeUnified) {
+		if settings := groupExpandExchangeSettings(d, false); settings != nil {
+			settings.ID = group.ID
+			if _, err := client.Update(ctx, *settings); err != nil {
+				return tf.ODataErrorDiagF(err, "Failed to set Microsoft 365 settings for group with object ID: %q", d.Id())
+			}
+		}
+	}
+
+	// The writeback configuration is not modelled by the SDK, and must be patched in a separate request
+	if d.Get("writeback_enabled").(bool) || d.Get("onpremises_group_type").(string) != "" {
+		if _, err := writebackClient.Update(ctx, d.Id(), groupExpandWritebackConfiguration(d)); err != nil {
+			return tf.ErrorDiagF(err, "Failed to set writeback configuration for group with object ID: %q", d.Id())
+		}
 	}
 
 	// Add any remaining owners after the group is created
@@ -570,39 +764,56 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	// Add members after the group is created
-	members := make(msgraph.Members, 0)
-	if v, ok := d.GetOk("members"); ok {
-		for _, memberId := range v.(*schema.Set).List() {
-			memberObject, _, err := directoryObjectsClient.Get(ctx, memberId.(string), odata.Query{})
-			if err != nil {
-				return tf.ErrorDiagF(err, "Could not retrieve member principal object %q", memberId)
-			}
-			if memberObject == nil {
-				return tf.ErrorDiagF(errors.New("memberObject was nil"), "Could not retrieve member principal object %q", memberId)
-			}
-			// TODO: remove this workaround for https://github.com/hashicorp/terraform-provider-azuread/issues/588
-			//if memberObject.ODataId == nil {
-			//	return tf.ErrorDiagF(errors.New("ODataId was nil"), "Could not retrieve member principal object %q", memberId)
-			//}
-			memberObject.ODataId = (*odata.Id)(utils.String(fmt.Sprintf("%s/v1.0/%s/directoryObjects/%s",
-				client.BaseClient.Endpoint, client.BaseClient.TenantId, memberId)))
-
-			members = append(members, *memberObject)
-		}
+	desiredMembers := tf.ExpandStringSlice(d.Get("members").(*schema.Set).List())
+	members, missingMembers, err := groupResolveMembers(ctx, client, directoryObjectsClient, desiredMembers, d.Get("ignore_missing_members").(bool))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "members", "Could not retrieve members for group with object ID %q", d.Id())
+	}
+	if len(missingMembers) > 0 {
+		diags = append(diags, groupMissingMembersWarning(d.Id(), missingMembers))
+		desiredMembers = utils.Difference(desiredMembers, missingMembers)
 	}
 	if len(members) > 0 {
-		group.Members = &members
-		if _, err := client.AddMembers(ctx, group); err != nil {
+		if _, err := groupAddMembers(ctx, client, d.Id(), members); err != nil {
 			return tf.ErrorDiagF(err, "Could not add members to group with object ID: %q", d.Id())
 		}
+
+		if err := groupWaitForMembers(ctx, client, d.Id(), desiredMembers); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for members of group with object ID %q to be updated", d.Id())
+		}
+	}
+
+	// Add the group to any administrative units after it is created
+	if v, ok := d.GetOk("administrative_unit_ids"); ok {
+		administrativeUnitIds := tf.ExpandStringSlice(v.(*schema.Set).List())
+		if err := groupUpdateAdministrativeUnits(ctx, meta.(*clients.Client).Groups.AdministrativeUnitsClient, d.Id(), administrativeUnitIds, nil); err != nil {
+			return tf.ErrorDiagPathF(err, "administrative_unit_ids", "Could not add group with object ID %q to administrative units", d.Id())
+		}
+
+		if err := groupWaitForAdministrativeUnits(ctx, meta.(*clients.Client).Groups.GroupAdministrativeUnitsClient, d.Id(), administrativeUnitIds); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for administrative units of group with object ID %q to be updated", d.Id())
+		}
+	}
+
+	// Wait for the initial owners to be consistently reflected, since these are read back immediately
+	desiredOwners := make([]string, 0)
+	for _, owner := range append(ownersFirst20, ownersExtra...) {
+		desiredOwners = append(desiredOwners, *owner.ID)
+	}
+	if err := groupWaitForOwners(ctx, client, d.Id(), desiredOwners); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for owners of group with object ID %q to be updated", d.Id())
 	}
 
-	return groupResourceRead(ctx, d, meta)
+	return append(diags, groupResourceRead(ctx, d, meta)...)
 }
 
 func groupResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.GroupsClient
+	writebackClient := meta.(*clients.Client).Groups.GroupWritebackClient
 	directoryObjectsClient := meta.(*clients.Client).Groups.DirectoryObjectsClient
+	if err := meta.(*clients.Client).LoadClaims(); err != nil {
+		return tf.ErrorDiagF(err, "Could not obtain claims for the authenticated principal")
+	}
 	callerId := meta.(*clients.Client).Claims.ObjectId
 
 	groupId := d.Id()
@@ -651,8 +862,12 @@ func groupResourceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		group.MembershipRule = utils.NullableString(d.Get("dynamic_membership.0.rule").(string))
 	}
 
-	if theme := d.Get("theme").(string); theme != "" {
-		group.Theme = utils.NullableString(theme)
+	if d.HasChange("classification") {
+		group.Classification = utils.String(d.Get("classification").(string))
+	}
+
+	if d.HasChange("theme") {
+		group.Theme = utils.NullableString(d.Get("theme").(string))
 	}
 
 	if d.HasChange("visibility") {
@@ -660,9 +875,43 @@ func groupResourceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if _, err := client.Update(ctx, group); err != nil {
-		return tf.ErrorDiagF(err, "Updating group with ID: %q", d.Id())
+		if groupIsClassificationError(err) {
+			return groupClassificationErrorDiag(err, d.Get("classification").(string))
+		}
+		return tf.ODataErrorDiagF(err, "Updating group with ID: %q", d.Id())
 	}
 
+	// The Exchange-backed settings for Microsoft 365 groups must be patched separately from other properties
+	if settings := groupExpandExchangeSettings(d, true); settings != nil {
+		settings.ID = group.ID
+		if _, err := client.Update(ctx, *settings); err != nil {
+			return tf.ODataErrorDiagF(err, "Updating Microsoft 365 settings for group with ID: %q", d.Id())
+		}
+	}
+
+	if d.HasChanges("onpremises_group_type", "writeback_enabled") {
+		if _, err := writebackClient.Update(ctx, d.Id(), groupExpandWritebackConfiguration(d)); err != nil {
+			return tf.ErrorDiagF(err, "Updating writeback configuration for group with ID: %q", d.Id())
+		}
+	}
+
+	if d.HasChange("administrative_unit_ids") {
+		oldAdministrativeUnits, newAdministrativeUnits := d.GetChange("administrative_unit_ids")
+		existingAdministrativeUnitIds := tf.ExpandStringSlice(oldAdministrativeUnits.(*schema.Set).List())
+		desiredAdministrativeUnitIds := tf.ExpandStringSlice(newAdministrativeUnits.(*schema.Set).List())
+		administrativeUnitsToAdd := utils.Difference(desiredAdministrativeUnitIds, existingAdministrativeUnitIds)
+		administrativeUnitsForRemoval := utils.Difference(existingAdministrativeUnitIds, desiredAdministrativeUnitIds)
+
+		if err := groupUpdateAdministrativeUnits(ctx, meta.(*clients.Client).Groups.AdministrativeUnitsClient, d.Id(), administrativeUnitsToAdd, administrativeUnitsForRemoval); err != nil {
+			return tf.ErrorDiagPathF(err, "administrative_unit_ids", "Could not update administrative units for group with object ID %q", d.Id())
+		}
+
+		if err := groupWaitForAdministrativeUnits(ctx, meta.(*clients.Client).Groups.GroupAdministrativeUnitsClient, d.Id(), desiredAdministrativeUnitIds); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for administrative units of group with object ID %q to be updated", d.Id())
+		}
+	}
+
+	var diags diag.Diagnostics
 	if d.HasChange("members") {
 		members, _, err := client.ListMembers(ctx, *group.ID)
 		if err != nil {
@@ -681,28 +930,26 @@ func groupResourceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 
 		if len(membersToAdd) > 0 {
-			newMembers := make(msgraph.Members, 0)
-			for _, memberId := range membersToAdd {
-				memberObject, _, err := directoryObjectsClient.Get(ctx, memberId, odata.Query{})
-				if err != nil {
-					return tf.ErrorDiagF(err, "Could not retrieve principal object %q", memberId)
-				}
-				if memberObject == nil {
-					return tf.ErrorDiagF(errors.New("returned memberObject was nil"), "Could not retrieve member principal object %q", memberId)
-				}
-				// TODO: remove this workaround for https://github.com/hashicorp/terraform-provider-azuread/issues/588
-				//if ownerObject.ODataId == nil {
-				//	return tf.ErrorDiagF(errors.New("ODataId was nil"), "Could not retrieve owner principal object %q", memberId)
-				//}
-				memberObject.ODataId = (*odata.Id)(utils.String(fmt.Sprintf("%s/v1.0/%s/directoryObjects/%s",
-					client.BaseClient.Endpoint, client.BaseClient.TenantId, memberId)))
+			newMembers, missingMembers, err := groupResolveMembers(ctx, client, directoryObjectsClient, membersToAdd, d.Get("ignore_missing_members").(bool))
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "members", "Could not retrieve members for group with object ID %q", d.Id())
+			}
+			if len(missingMembers) > 0 {
+				diags = append(diags, groupMissingMembersWarning(d.Id(), missingMembers))
+				membersToAdd = utils.Difference(membersToAdd, missingMembers)
+				desiredMembers = utils.Difference(desiredMembers, missingMembers)
+			}
 
-				newMembers = append(newMembers, *memberObject)
+			if len(newMembers) > 0 {
+				if _, err := groupAddMembers(ctx, client, d.Id(), newMembers); err != nil {
+					return tf.ErrorDiagF(err, "Could not add members to group with object ID: %q", d.Id())
+				}
 			}
+		}
 
-			group.Members = &newMembers
-			if _, err := client.AddMembers(ctx, &group); err != nil {
-				return tf.ErrorDiagF(err, "Could not add members to group with object ID: %q", d.Id())
+		if len(membersForRemoval) > 0 || len(membersToAdd) > 0 {
+			if err := groupWaitForMembers(ctx, client, d.Id(), desiredMembers); err != nil {
+				return tf.ErrorDiagF(err, "Waiting for members of group with object ID %q to be updated", d.Id())
 			}
 		}
 	}
@@ -756,13 +1003,20 @@ func groupResourceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return tf.ErrorDiagF(err, "Could not remove owners from group with object ID: %q", d.Id())
 			}
 		}
+
+		if len(ownersForRemoval) > 0 || len(ownersToAdd) > 0 {
+			if err := groupWaitForOwners(ctx, client, d.Id(), desiredOwners); err != nil {
+				return tf.ErrorDiagF(err, "Waiting for owners of group with object ID %q to be updated", d.Id())
+			}
+		}
 	}
 
-	return groupResourceRead(ctx, d, meta)
+	return append(diags, groupResourceRead(ctx, d, meta)...)
 }
 
 func groupResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.GroupsClient
+	writebackClient := meta.(*clients.Client).Groups.GroupWritebackClient
 
 	group, status, err := client.Get(ctx, d.Id(), odata.Query{})
 	if err != nil {
@@ -774,8 +1028,17 @@ func groupResourceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return tf.ErrorDiagF(err, "Retrieving group with object ID: %q", d.Id())
 	}
 
+	// Administrative units may not be readable with the permissions used to manage the group, so retain the existing
+	// value when they cannot be retrieved
+	if administrativeUnitIds, _, err := meta.(*clients.Client).Groups.GroupAdministrativeUnitsClient.List(ctx, d.Id()); err != nil {
+		log.Printf("[DEBUG] Unable to read administrative units for group with ID %q, retaining existing value: %v", d.Id(), err)
+	} else {
+		tf.Set(d, "administrative_unit_ids", administrativeUnitIds)
+	}
+
 	tf.Set(d, "assignable_to_role", group.IsAssignableToRole)
 	tf.Set(d, "behaviors", tf.FlattenStringSlice(group.ResourceBehaviorOptions))
+	tf.Set(d, "classification", group.Classification)
 	tf.Set(d, "description", group.Description)
 	tf.Set(d, "display_name", group.DisplayName)
 	tf.Set(d, "mail_enabled", group.MailEnabled)
@@ -808,6 +1071,49 @@ func groupResourceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	tf.Set(d, "dynamic_membership", dynamicMembership)
 
+	// The Exchange-backed settings are only returned when explicitly selected, and only exist for Microsoft 365 groups
+	var autoSubscribeNewMembers, hideFromAddressLists, hideFromOutlookClients bool
+	if hasGroupType(group.GroupTypes, msgraph.GroupTypeUnified) {
+		settings, status, err := client.Get(ctx, d.Id(), odata.Query{
+			Select: []string{"autoSubscribeNewMembers", "hideFromAddressLists", "hideFromOutlookClients"},
+		})
+		if err != nil {
+			if status != http.StatusForbidden {
+				return tf.ErrorDiagF(err, "Retrieving Microsoft 365 settings for group with object ID: %q", d.Id())
+			}
+
+			// These settings cannot be read using application-only authentication, so retain the existing values
+			log.Printf("[DEBUG] Unable to read Microsoft 365 settings for group with ID %q, retaining existing values", d.Id())
+			settings = &msgraph.Group{
+				AutoSubscribeNewMembers: utils.Bool(d.Get("auto_subscribe_new_members").(bool)),
+				HideFromAddressLists:    utils.Bool(d.Get("hide_from_address_lists").(bool)),
+				HideFromOutlookClients:  utils.Bool(d.Get("hide_from_outlook_clients").(bool)),
+			}
+		}
+
+		if settings.AutoSubscribeNewMembers != nil {
+			autoSubscribeNewMembers = *settings.AutoSubscribeNewMembers
+		}
+		if settings.HideFromAddressLists != nil {
+			hideFromAddressLists = *settings.HideFromAddressLists
+		}
+		if settings.HideFromOutlookClients != nil {
+			hideFromOutlookClients = *settings.HideFromOutlookClients
+		}
+	}
+	tf.Set(d, "auto_subscribe_new_members", autoSubscribeNewMembers)
+	tf.Set(d, "hide_from_address_lists", hideFromAddressLists)
+	tf.Set(d, "hide_from_outlook_clients", hideFromOutlookClients)
+
+	// Writeback configuration is retrieved from the beta API and may not be readable in all tenants, so retain the
+	// existing values when it cannot be retrieved
+	if writeback, _, err := writebackClient.Get(ctx, d.Id()); err != nil {
+		log.Printf("[DEBUG] Unable to read writeback configuration for group with ID %q, retaining existing values: %v", d.Id(), err)
+	} else {
+		tf.Set(d, "onpremises_group_type", writeback.OnPremisesGroupType)
+		tf.Set(d, "writeback_enabled", writeback.IsEnabled != nil && *writeback.IsEnabled)
+	}
+
 	owners, _, err := client.ListOwners(ctx, *group.ID)
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for group with object ID %q", d.Id())
@@ -820,6 +1126,27 @@ func groupResourceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	tf.Set(d, "members", members)
 
+	memberCount, ownerCount, err := groupCounts(ctx, client, *group.ID, members, owners)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not count members and owners for group with object ID %q", d.Id())
+	}
+	tf.Set(d, "member_count", memberCount)
+	tf.Set(d, "owner_count", ownerCount)
+
+	// Transitive members may not be readable with the same permissions as direct members, so retain the existing value
+	// when they cannot be counted
+	if transitiveMemberCount, err := groupTransitiveMemberCount(ctx, client, *group.ID); err != nil {
+		log.Printf("[DEBUG] Unable to count transitive members for group with ID %q, retaining existing value: %v", d.Id(), err)
+	} else {
+		tf.Set(d, "transitive_member_count", transitiveMemberCount)
+	}
+
+	ignoreMissingMembers := false
+	if v := d.Get("ignore_missing_members").(bool); v {
+		ignoreMissingMembers = v
+	}
+	tf.Set(d, "ignore_missing_members", ignoreMissingMembers)
+
 	preventDuplicates := false
 	if v := d.Get("prevent_duplicate_names").(bool); v {
 		preventDuplicates = v
@@ -842,7 +1169,7 @@ func groupResourceDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if _, err := client.Delete(ctx, groupId); err != nil {
-		return tf.ErrorDiagF(err, "Deleting group with object ID: %q", groupId)
+		return tf.ODataErrorDiagF(err, "Deleting group with object ID: %q", groupId)
 	}
 
 	// Wait for group object to be deleted
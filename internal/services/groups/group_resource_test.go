@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
@@ -28,6 +31,7 @@ func TestAccGroup_basic(t *testing.T) {
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("mail_nickname").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
 			),
 		},
 		data.ImportStep(),
@@ -78,6 +82,74 @@ func TestAccGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccGroup_theme(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.unified(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("theme").HasValue("Pink"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.themeAndClassification(data, "Teal", ""),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("theme").HasValue("Teal"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.themeAndClassification(data, "", ""),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("theme").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+// Classifications must be configured in the tenant's Group.Unified directory setting, so this test requires the name
+// of an existing classification
+func TestAccGroup_classification(t *testing.T) {
+	classification := os.Getenv("ARM_TEST_GROUP_CLASSIFICATION")
+	if classification == "" {
+		t.Skip("ARM_TEST_GROUP_CLASSIFICATION must be set for group classification tests")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.themeAndClassification(data, "Orange", classification),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("classification").HasValue(classification),
+				check.That(data.ResourceName).Key("theme").HasValue("Orange"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroup_classificationInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.themeAndClassification(data, "Orange", fmt.Sprintf("acctest-%s", data.RandomString)),
+			ExpectError: regexp.MustCompile("was not accepted for this group"),
+		},
+	})
+}
+
 func TestAccGroup_assignableToRole(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -87,12 +159,38 @@ func TestAccGroup_assignableToRole(t *testing.T) {
 			Config: r.assignableToRole(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignable_to_role").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.assignableToRoleWithDirectoryRoleAssignment(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_directory_role_assignment.test").Key("id").Exists(),
+				check.That("azuread_directory_role_assignment.test").Key("principal_object_id").MatchesOtherKey(check.That(data.ResourceName).Key("object_id")),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
+func TestAccGroup_assignableToRoleInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.assignableToRoleNotSecurityEnabled(data),
+			ExpectError: regexp.MustCompile("`assignable_to_role` can only be `true` for security-enabled groups"),
+		},
+		{
+			Config:      r.assignableToRoleDynamicMembership(data),
+			ExpectError: regexp.MustCompile("role-assignable groups do not support dynamic membership"),
+		},
+	})
+}
+
 func TestAccGroup_behaviors(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -108,6 +206,145 @@ func TestAccGroup_behaviors(t *testing.T) {
 	})
 }
 
+func TestAccGroup_unifiedWithoutOwners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.unifiedWithoutOwners(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				resource.TestCheckTypeSetElemAttrPair(data.ResourceName, "owners.*", "data.azuread_client_config.test", "object_id"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroup_unifiedSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.unifiedSettings(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_subscribe_new_members").HasValue("true"),
+				check.That(data.ResourceName).Key("hide_from_address_lists").HasValue("true"),
+				check.That(data.ResourceName).Key("hide_from_outlook_clients").HasValue("true"),
+				check.That(data.ResourceName).Key("visibility").HasValue("Private"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.unifiedSettings(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_subscribe_new_members").HasValue("false"),
+				check.That(data.ResourceName).Key("hide_from_address_lists").HasValue("false"),
+				check.That(data.ResourceName).Key("hide_from_outlook_clients").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroup_writeback(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.writeback(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("writeback_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("onpremises_group_type").HasValue("UniversalSecurityGroup"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.writeback(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("writeback_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroup_writebackInvalidGroupType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.writebackInvalidGroupType(data),
+			ExpectError: regexp.MustCompile("`onpremises_group_type` must be \"UniversalSecurityGroup\" for security groups"),
+		},
+	})
+}
+
+func TestAccGroup_mailNicknameRequired(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.mailNickname(data, ""),
+			ExpectError: regexp.MustCompile("`mail_nickname` is required for mail-enabled groups"),
+		},
+	})
+}
+
+func TestAccGroup_mailNicknameInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.mailNickname(data, fmt.Sprintf("acctest Group %d", data.RandomInteger)),
+			ExpectError: regexp.MustCompile("Value cannot contain these characters"),
+		},
+	})
+}
+
+func TestAccGroup_administrativeUnit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.administrativeUnits(data, "[azuread_administrative_unit.test.object_id]"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrative_unit_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.administrativeUnits(data, "[azuread_administrative_unit.test.object_id, azuread_administrative_unit.test2.object_id]"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrative_unit_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.administrativeUnits(data, "[]"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrative_unit_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccGroup_dynamicMembership(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -257,6 +494,38 @@ func TestAccGroup_members(t *testing.T) {
 	})
 }
 
+func TestAccGroup_ignoreMissingMembers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+	var memberId string
+
+	// The out-of-band member is passed to the configuration using a variable, so that it remains in configuration after
+	// it is deleted
+	defer os.Unsetenv("TF_VAR_missing_member_id")
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			PreConfig: r.createMemberOutOfBand(t, data, &memberId),
+			Config:    r.ignoreMissingMembers(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("ignore_missing_members"),
+		{
+			// The deleted member remains in configuration, so the plan will not be empty, but applying should not fail
+			PreConfig:          r.deleteMemberOutOfBand(t, &memberId),
+			Config:             r.ignoreMissingMembers(data),
+			ExpectNonEmptyPlan: true,
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("1"),
+			),
+		},
+	})
+}
+
 func TestAccGroup_membersAndOwners(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -268,6 +537,9 @@ func TestAccGroup_membersAndOwners(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("members.#").HasValue("2"),
 				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				check.That(data.ResourceName).Key("member_count").HasValue("2"),
+				check.That(data.ResourceName).Key("owner_count").HasValue("1"),
+				check.That(data.ResourceName).Key("transitive_member_count").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
@@ -324,6 +596,24 @@ func TestAccGroup_preventDuplicateNamesFail(t *testing.T) {
 	})
 }
 
+func TestAccGroup_preventDuplicateNamesCaseInsensitiveFail(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		data.RequiresImportErrorStep(r.preventDuplicateNamesCaseInsensitiveFail(data)),
+	})
+}
+
+func TestAccGroup_preventDuplicateMailNicknamesFail(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		data.RequiresImportErrorStep(r.preventDuplicateMailNicknamesFail(data)),
+	})
+}
+
 func TestAccGroup_provisioning(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -361,6 +651,50 @@ func TestAccGroup_visibility(t *testing.T) {
 	})
 }
 
+func TestAccGroup_visibilityHiddenMembership(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+	var objectId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.visibility(data, "Private"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.objectId(data, &objectId, false),
+			),
+		},
+		{
+			// Switching to hidden membership should replace the group
+			Config: r.visibility(data, "Hiddenmembership"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("visibility").HasValue("Hiddenmembership"),
+				r.objectId(data, &objectId, false),
+			),
+		},
+		data.ImportStep(),
+		{
+			// Switching away from hidden membership should also replace the group
+			Config: r.visibility(data, "Public"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("visibility").HasValue("Public"),
+				r.objectId(data, &objectId, false),
+			),
+		},
+		{
+			// Switching between Public and Private should update the group in place
+			Config: r.visibility(data, "Private"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("visibility").HasValue("Private"),
+				r.objectId(data, &objectId, true),
+			),
+		},
+	})
+}
+
 func (r GroupResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Groups.GroupsClient
 	client.BaseClient.DisableRetries = true
@@ -452,6 +786,25 @@ resource "azuread_group" "test" {
 `, data.RandomInteger)
 }
 
+func (GroupResource) themeAndClassification(data acceptance.TestData, theme, classification string) string {
+	classificationValue := "null"
+	if classification != "" {
+		classificationValue = fmt.Sprintf("%q", classification)
+	}
+
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  types            = ["Unified"]
+  mail_enabled     = true
+  mail_nickname    = "acctestGroup-%[1]d"
+  security_enabled = true
+  theme            = %[2]q
+  classification   = %[3]s
+}
+`, data.RandomInteger, theme, classificationValue)
+}
+
 func (GroupResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 data "azuread_domains" "test" {
@@ -488,6 +841,54 @@ resource "azuread_group" "test" {
 `, data.RandomInteger)
 }
 
+func (GroupResource) assignableToRoleWithDirectoryRoleAssignment(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  assignable_to_role = true
+  display_name       = "acctestGroup-assignableToRole-%[1]d"
+  security_enabled   = true
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Global Reader"
+}
+
+resource "azuread_directory_role_assignment" "test" {
+  role_id             = azuread_directory_role.test.template_id
+  principal_object_id = azuread_group.test.object_id
+}
+`, data.RandomInteger)
+}
+
+func (GroupResource) assignableToRoleNotSecurityEnabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  assignable_to_role = true
+  display_name       = "acctestGroup-assignableToRole-%[1]d"
+  mail_enabled       = true
+  mail_nickname      = "acctestGroup-assignableToRole-%[1]d"
+  security_enabled   = false
+  types              = ["Unified"]
+}
+`, data.RandomInteger)
+}
+
+func (GroupResource) assignableToRoleDynamicMembership(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  assignable_to_role = true
+  display_name       = "acctestGroup-assignableToRole-%[1]d"
+  security_enabled   = true
+  types              = ["DynamicMembership"]
+
+  dynamic_membership {
+    enabled = true
+    rule    = "user.department -eq \"Sales\""
+  }
+}
+`, data.RandomInteger)
+}
+
 func (GroupResource) behaviors(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
@@ -506,6 +907,87 @@ resource "azuread_group" "test" {
 `, data.RandomInteger)
 }
 
+func (GroupResource) unifiedWithoutOwners(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_client_config" "test" {}
+
+resource "azuread_group" "test" {
+  display_name  = "acctestGroup-%[1]d"
+  types         = ["Unified"]
+  mail_enabled  = true
+  mail_nickname = "acctestGroup-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (GroupResource) unifiedSettings(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name  = "acctestGroup-settings-%[1]d"
+  mail_enabled  = true
+  mail_nickname = "acctestGroup-settings-%[1]d"
+  types         = ["Unified"]
+  visibility    = "Private"
+
+  auto_subscribe_new_members = %[2]t
+  hide_from_address_lists    = %[2]t
+  hide_from_outlook_clients  = %[2]t
+}
+`, data.RandomInteger, enabled)
+}
+
+func (GroupResource) writeback(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name          = "acctestGroup-writeback-%[1]d"
+  security_enabled      = true
+  writeback_enabled     = %[2]t
+  onpremises_group_type = "UniversalSecurityGroup"
+}
+`, data.RandomInteger, enabled)
+}
+
+func (GroupResource) writebackInvalidGroupType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name          = "acctestGroup-writeback-%[1]d"
+  security_enabled      = true
+  writeback_enabled     = true
+  onpremises_group_type = "UniversalDistributionGroup"
+}
+`, data.RandomInteger)
+}
+
+func (GroupResource) mailNickname(data acceptance.TestData, mailNickname string) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  types            = ["Unified"]
+  mail_enabled     = true
+  mail_nickname    = "%[2]s"
+  security_enabled = true
+}
+`, data.RandomInteger, mailNickname)
+}
+
+func (GroupResource) administrativeUnits(data acceptance.TestData, administrativeUnitIds string) string {
+	return fmt.Sprintf(`
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestGroup-administrative-unit-%[1]d"
+}
+
+resource "azuread_administrative_unit" "test2" {
+  display_name = "acctestGroup-administrative-unit-%[1]d-2"
+}
+
+resource "azuread_group" "test" {
+  display_name            = "acctestGroup-%[1]d"
+  security_enabled        = true
+  administrative_unit_ids = %[2]s
+}
+`, data.RandomInteger, administrativeUnitIds)
+}
+
 func (GroupResource) dynamicMembership(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
@@ -549,6 +1031,59 @@ resource "azuread_group" "test" {
 `, data.RandomInteger, visibility)
 }
 
+// objectId records the object ID of the group in state. When expectSame is true, it instead checks that the object
+// ID matches the previously recorded value, and when false, that it differs from any previously recorded value.
+func (GroupResource) objectId(data acceptance.TestData, objectId *string, expectSame bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		group, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		if expectSame && group.Primary.ID != *objectId {
+			return fmt.Errorf("expected group to be updated in place with object ID %q, got %q", *objectId, group.Primary.ID)
+		}
+		if !expectSame && *objectId != "" && group.Primary.ID == *objectId {
+			return fmt.Errorf("expected group to be replaced, but object ID %q was unchanged", *objectId)
+		}
+		*objectId = group.Primary.ID
+		return nil
+	}
+}
+
+func (GroupResource) createMemberOutOfBand(t *testing.T, data acceptance.TestData, memberId *string) func() {
+	return func() {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Groups.GroupsClient
+
+		group, _, err := client.Create(clients.StopContext, msgraph.Group{
+			DisplayName:     utils.String(fmt.Sprintf("acctestGroup-%d-OutOfBand", data.RandomInteger)),
+			MailEnabled:     utils.Bool(false),
+			MailNickname:    utils.String(fmt.Sprintf("acctestGroup-%d-OutOfBand", data.RandomInteger)),
+			SecurityEnabled: utils.Bool(true),
+		})
+		if err != nil {
+			t.Fatalf("creating out-of-band member group: %+v", err)
+		}
+		if group == nil || group.ID == nil {
+			t.Fatalf("creating out-of-band member group: nil group or group with nil ID returned")
+		}
+
+		*memberId = *group.ID
+		os.Setenv("TF_VAR_missing_member_id", *memberId)
+	}
+}
+
+func (GroupResource) deleteMemberOutOfBand(t *testing.T, memberId *string) func() {
+	return func() {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Groups.GroupsClient
+
+		if _, err := client.Delete(clients.StopContext, *memberId); err != nil {
+			t.Fatalf("deleting out-of-band member group with object ID %q: %+v", *memberId, err)
+		}
+	}
+}
+
 func (r GroupResource) withOneOwner(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -668,6 +1203,26 @@ resource "azuread_group" "test" {
 `, r.templateDiverseDirectoryObjects(data), data.RandomInteger)
 }
 
+func (r GroupResource) ignoreMissingMembers(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+variable "missing_member_id" {
+  type = string
+}
+
+resource "azuread_group" "test" {
+  display_name           = "acctestGroup-%[2]d"
+  security_enabled       = true
+  ignore_missing_members = true
+  members = [
+    azuread_user.testA.object_id,
+    var.missing_member_id,
+  ]
+}
+`, r.templateThreeUsers(data), data.RandomInteger)
+}
+
 func (r GroupResource) withThreeMembers(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -770,6 +1325,33 @@ resource "azuread_group" "test" {
 `, r.manyObjectsTemplate(data), data.RandomInteger)
 }
 
+func (r GroupResource) preventDuplicateNamesCaseInsensitiveFail(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "duplicate" {
+  display_name            = upper(azuread_group.test.display_name)
+  security_enabled        = true
+  prevent_duplicate_names = true
+}
+`, r.basic(data))
+}
+
+func (r GroupResource) preventDuplicateMailNicknamesFail(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "duplicate" {
+  display_name            = "acctestGroup-duplicate-%[2]d"
+  types                   = ["Unified"]
+  mail_enabled            = true
+  mail_nickname           = azuread_group.test.mail_nickname
+  security_enabled        = true
+  prevent_duplicate_names = true
+}
+`, r.unified(data), data.RandomInteger)
+}
+
 func (GroupResource) preventDuplicateNamesPass(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
@@ -2,14 +2,33 @@ package groups
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"log"
 	"math/rand"
+	"net/http"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	groupsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
+// groupMembersBatchSize is the maximum number of member references that can be added to a group in a single request
+const groupMembersBatchSize = 20
+
+// groupMailNicknameMaxLength is the maximum permitted length of the mailNickname property for a group
+const groupMailNicknameMaxLength = 64
+
 func groupDefaultMailNickname() string {
 	charSet := "0123456789abcdef"
 	result := make([]byte, 9)
@@ -21,9 +40,172 @@ func groupDefaultMailNickname() string {
 	return resultString[:8] + "-" + resultString[8:]
 }
 
+// groupDerivedMailNickname derives a mailNickname from the display name of a group, by removing any characters that are
+// not permitted, including periods and any non-ASCII characters. Returns a random value when the resulting nickname
+// would be empty.
+func groupDerivedMailNickname(displayName string) string {
+	mailNickname := strings.Map(func(r rune) rune {
+		if r == '.' || r > unicode.MaxASCII {
+			return -1
+		}
+		return r
+	}, helpers.DeriveMailNickname(displayName))
+	if len(mailNickname) > groupMailNicknameMaxLength {
+		mailNickname = mailNickname[:groupMailNicknameMaxLength]
+	}
+	if mailNickname == "" {
+		return groupDefaultMailNickname()
+	}
+	return mailNickname
+}
+
+// groupUpdateAdministrativeUnits adds a group as a member of, and removes it from, the specified administrative units
+func groupUpdateAdministrativeUnits(ctx context.Context, client *msgraph.AdministrativeUnitsClient, groupId string, toAdd, toRemove []string) error {
+	for _, administrativeUnitId := range toAdd {
+		members := msgraph.Members{{
+			ODataId: (*odata.Id)(utils.String(fmt.Sprintf("%s/v1.0/%s/directoryObjects/%s",
+				client.BaseClient.Endpoint, client.BaseClient.TenantId, groupId))),
+			ID: utils.String(groupId),
+		}}
+		if _, err := client.AddMembers(ctx, administrativeUnitId, &members); err != nil {
+			return fmt.Errorf("adding group to administrative unit with object ID %q: %+v", administrativeUnitId, err)
+		}
+	}
+
+	for _, administrativeUnitId := range toRemove {
+		if _, err := client.RemoveMembers(ctx, administrativeUnitId, &[]string{groupId}); err != nil {
+			return fmt.Errorf("removing group from administrative unit with object ID %q: %+v", administrativeUnitId, err)
+		}
+	}
+
+	return nil
+}
+
+func hasGroupType(groupTypes []msgraph.GroupType, value msgraph.GroupType) bool {
+	for _, v := range groupTypes {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// groupValidateMembersManageable returns an error when the group is synchronized from an on-premises directory, since
+// the membership of synchronized groups can only be managed on-premises
+func groupValidateMembersManageable(groupId string, onPremisesSyncEnabled *bool) error {
+	if onPremisesSyncEnabled != nil && *onPremisesSyncEnabled {
+		return fmt.Errorf("the members of group %q cannot be managed because it is synchronized from an on-premises directory. Manage its membership on-premises instead", groupId)
+	}
+	return nil
+}
+
+// groupHasUserOwner returns true when any of the provided owners is a user
+func groupHasUserOwner(owners msgraph.Owners) bool {
+	for _, owner := range owners {
+		if owner.ODataType != nil && *owner.ODataType == odata.TypeUser {
+			return true
+		}
+	}
+	return false
+}
+
+// groupUnifiedOwnersErrorDiag returns a diagnostic for a Microsoft 365 group that could not be created without a user
+// owner, which can be required when Terraform is authenticated as a service principal
+func groupUnifiedOwnersErrorDiag(err error, displayName string) diag.Diagnostics {
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Could not create Microsoft 365 group %q without a user owner", displayName),
+		Detail: fmt.Sprintf("Microsoft 365 groups must have at least one owner, and the API may require this to be a user. When Terraform is authenticated as a service principal and no user owners are specified, specify one or more user object IDs in the `owners` property.\n\nAPI error: %v",
+			err),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "owners"}},
+	}}
+}
+
+// groupIsClassificationError returns true when the API rejected the classification for a group, which happens when the
+// value is not one of the classifications configured for the tenant, or when no classifications are configured
+func groupIsClassificationError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "property 'classification'")
+}
+
+// groupClassificationErrorDiag returns a diagnostic for a rejected group classification
+func groupClassificationErrorDiag(err error, classification string) diag.Diagnostics {
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("The classification %q was not accepted for this group", classification),
+		Detail: fmt.Sprintf("The classification must be one of the values in the `ClassificationList` of the tenant's `Group.Unified` directory setting. If no classifications are configured for the tenant, the `classification` property cannot be set.\n\nAPI error: %v",
+			err),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "classification"}},
+	}}
+}
+
+// groupExpandExchangeSettings returns a Group populated with the Exchange-backed settings for a Microsoft 365 group,
+// which cannot be combined with other properties in the same request. When onlyChanged is true, only settings having
+// a pending change are included. Returns nil when there are no settings to patch.
+func groupExpandExchangeSettings(d *schema.ResourceData, onlyChanged bool) *msgraph.Group {
+	var settings msgraph.Group
+	found := false
+
+	for field, target := range map[string]**bool{
+		"auto_subscribe_new_members": &settings.AutoSubscribeNewMembers,
+		"hide_from_address_lists":    &settings.HideFromAddressLists,
+		"hide_from_outlook_clients":  &settings.HideFromOutlookClients,
+	} {
+		if onlyChanged && !d.HasChange(field) {
+			continue
+		}
+		if v, ok := d.GetOk(field); ok || onlyChanged {
+			*target = utils.Bool(v.(bool))
+			found = true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return &settings
+}
+
+// groupExpandWritebackConfiguration returns the writeback configuration for a group, as specified in its configuration
+func groupExpandWritebackConfiguration(d *schema.ResourceData) groupsClient.GroupWritebackConfiguration {
+	config := groupsClient.GroupWritebackConfiguration{
+		IsEnabled: utils.Bool(d.Get("writeback_enabled").(bool)),
+	}
+	if v := d.Get("onpremises_group_type").(string); v != "" {
+		config.OnPremisesGroupType = utils.String(v)
+	}
+	return config
+}
+
 func groupFindByName(ctx context.Context, client *msgraph.GroupsClient, displayName string) (*[]msgraph.Group, error) {
 	query := odata.Query{
-		Filter: fmt.Sprintf("displayName eq '%s'", displayName),
+		Filter: fmt.Sprintf("displayName eq '%s'", utils.EscapeSingleQuote(displayName)),
+	}
+	groups, _, err := client.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list Groups with filter %q: %+v", query.Filter, err)
+	}
+
+	// Group names are matched case-insensitively by the API, so do the same here
+	result := make([]msgraph.Group, 0)
+	if groups != nil {
+		for _, group := range *groups {
+			if group.DisplayName != nil && strings.EqualFold(*group.DisplayName, displayName) {
+				result = append(result, group)
+			}
+		}
+	}
+
+	return &result, nil
+}
+
+// groupFindByMailNickname returns any mail-enabled groups having the specified mail nickname, which must be unique
+// amongst mail-enabled objects in the tenant
+func groupFindByMailNickname(ctx context.Context, client *msgraph.GroupsClient, mailNickname string) (*[]msgraph.Group, error) {
+	query := odata.Query{
+		Filter: fmt.Sprintf("mailEnabled eq true and mailNickname eq '%s'", utils.EscapeSingleQuote(mailNickname)),
 	}
 	groups, _, err := client.List(ctx, query)
 	if err != nil {
@@ -33,7 +215,7 @@ func groupFindByName(ctx context.Context, client *msgraph.GroupsClient, displayN
 	result := make([]msgraph.Group, 0)
 	if groups != nil {
 		for _, group := range *groups {
-			if group.DisplayName != nil && *group.DisplayName == displayName {
+			if group.MailNickname != nil && strings.EqualFold(*group.MailNickname, mailNickname) {
 				result = append(result, group)
 			}
 		}
@@ -41,3 +223,249 @@ func groupFindByName(ctx context.Context, client *msgraph.GroupsClient, displayN
 
 	return &result, nil
 }
+
+// groupAddMembers adds the specified members to a group in batches of up to 20 member references per request, which is
+// considerably faster than adding members one at a time for large groups. Throttled requests are retried with backoff
+// by the underlying client. Should a batch be rejected because one or more of the members already exist, that batch is
+// retried one member at a time, tolerating any existing members.
+func groupAddMembers(ctx context.Context, client *msgraph.GroupsClient, id string, members msgraph.Members) (int, error) {
+	var status int
+
+	for _, batch := range groupMemberBatches(members, groupMembersBatchSize) {
+		refs := make([]string, 0, len(batch))
+		for _, member := range batch {
+			if member.ODataId == nil {
+				return status, fmt.Errorf("member with nil ODataId specified")
+			}
+			refs = append(refs, string(*member.ODataId))
+		}
+
+		body, err := json.Marshal(map[string][]string{"members@odata.bind": refs})
+		if err != nil {
+			return status, fmt.Errorf("json.Marshal(): %v", err)
+		}
+
+		_, status, _, err = client.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+			Body:                   body,
+			ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+			ValidStatusCodes:       []int{http.StatusNoContent},
+			Uri: msgraph.Uri{
+				Entity:      fmt.Sprintf("/groups/%s", id),
+				HasTenantId: true,
+			},
+		})
+		if err != nil {
+			if status != http.StatusBadRequest {
+				return status, fmt.Errorf("GroupsClient.BaseClient.Patch(): %v", err)
+			}
+
+			batchMembers := batch
+			if status, err = client.AddMembers(ctx, &msgraph.Group{
+				DirectoryObject: msgraph.DirectoryObject{
+					ID: &id,
+				},
+				Members: &batchMembers,
+			}); err != nil {
+				return status, err
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// groupResolveMembers retrieves the directory objects for the provided member IDs, so that they can be added to a
+// group. When ignoreMissing is true, members which no longer exist are omitted and their IDs are returned, otherwise
+// an error is returned for the first missing member.
+func groupResolveMembers(ctx context.Context, client *msgraph.GroupsClient, directoryObjectsClient *msgraph.DirectoryObjectsClient, memberIds []string, ignoreMissing bool) (msgraph.Members, []string, error) {
+	members := make(msgraph.Members, 0)
+	missing := make([]string, 0)
+
+	for _, memberId := range memberIds {
+		memberObject, status, err := directoryObjectsClient.Get(ctx, memberId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound && ignoreMissing {
+				missing = append(missing, memberId)
+				continue
+			}
+			return nil, nil, fmt.Errorf("retrieving member principal object %q: %v", memberId, err)
+		}
+		if memberObject == nil {
+			return nil, nil, fmt.Errorf("retrieving member principal object %q: memberObject was nil", memberId)
+		}
+		// TODO: remove this workaround for https://github.com/hashicorp/terraform-provider-azuread/issues/588
+		//if memberObject.ODataId == nil {
+		//	return nil, nil, fmt.Errorf("retrieving member principal object %q: ODataId was nil", memberId)
+		//}
+		memberObject.ODataId = (*odata.Id)(utils.String(fmt.Sprintf("%s/v1.0/%s/directoryObjects/%s",
+			client.BaseClient.Endpoint, client.BaseClient.TenantId, memberId)))
+
+		members = append(members, *memberObject)
+	}
+
+	return members, missing, nil
+}
+
+// groupMissingMembersWarning returns a warning diagnostic listing members that were not added to a group because they
+// no longer exist
+func groupMissingMembersWarning(groupId string, missing []string) diag.Diagnostic {
+	log.Printf("[WARN] Ignoring missing members for group with object ID %q: %s", groupId, strings.Join(missing, ", "))
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       fmt.Sprintf("Ignored %d missing member(s) of group with object ID %q", len(missing), groupId),
+		Detail:        fmt.Sprintf("The following members no longer exist and were not added to the group, since `ignore_missing_members` is set: %s. Remove them from the `members` property to avoid this warning.", strings.Join(missing, ", ")),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "members"}},
+	}
+}
+
+// groupMemberBatches splits the provided members into batches containing no more than `size` members each
+func groupMemberBatches(members msgraph.Members, size int) []msgraph.Members {
+	batches := make([]msgraph.Members, 0)
+	for size < len(members) {
+		members, batches = members[size:], append(batches, members[0:size:size])
+	}
+	if len(members) > 0 {
+		batches = append(batches, members)
+	}
+	return batches
+}
+
+// groupWaitForMembers waits for the members of a group to reflect the desired members, since membership changes are
+// not always immediately visible when reading the group back
+func groupWaitForMembers(ctx context.Context, client *msgraph.GroupsClient, id string, desiredMembers []string) error {
+	return helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		members, _, err := client.ListMembers(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if members == nil {
+			return utils.Bool(len(desiredMembers) == 0), nil
+		}
+		return utils.Bool(utils.EqualStringSets(*members, desiredMembers)), nil
+	})
+}
+
+// groupWaitForOwners waits for the owners of a group to reflect the desired owners, since ownership changes are
+// not always immediately visible when reading the group back
+func groupWaitForOwners(ctx context.Context, client *msgraph.GroupsClient, id string, desiredOwners []string) error {
+	return helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		owners, _, err := client.ListOwners(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if owners == nil {
+			return utils.Bool(len(desiredOwners) == 0), nil
+		}
+		return utils.Bool(utils.EqualStringSets(*owners, desiredOwners)), nil
+	})
+}
+
+func groupWaitForAdministrativeUnits(ctx context.Context, client *groupsClient.GroupAdministrativeUnitsClient, id string, desiredAdministrativeUnits []string) error {
+	return helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		administrativeUnits, _, err := client.List(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if administrativeUnits == nil {
+			return utils.Bool(len(desiredAdministrativeUnits) == 0), nil
+		}
+		return utils.Bool(utils.EqualStringSets(*administrativeUnits, desiredAdministrativeUnits)), nil
+	})
+}
+
+// groupListTransitiveMembers retrieves the object IDs of all members of the specified group, including those that are
+// members by way of nested group membership.
+func groupListTransitiveMembers(ctx context.Context, client *msgraph.GroupsClient, id string) (*[]string, int, error) {
+	resp, status, _, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData: odata.Query{
+			Select: []string{"id"},
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s/transitiveMembers", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Members []struct {
+			Id string `json:"id"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	result := make([]string, len(data.Members))
+	for i, v := range data.Members {
+		result[i] = v.Id
+	}
+
+	return &result, status, nil
+}
+
+// groupCountRelationship returns the number of objects in a relationship of a group, such as `members`, `owners` or
+// `transitiveMembers`, using an advanced query so that the objects do not need to be enumerated. Where advanced
+// queries are not permitted in the tenant, the objects returned by the fallback function are counted instead.
+func groupCountRelationship(ctx context.Context, client *msgraph.GroupsClient, groupId, relationship string, fallback func() (*[]string, error)) (int, error) {
+	count, err := helpers.CountDirectoryObjects(ctx, client.BaseClient, fmt.Sprintf("/groups/%s/%s", groupId, relationship), "")
+	if err == nil {
+		return count, nil
+	}
+
+	log.Printf("[DEBUG] Unable to count %s for group with object ID %q using an advanced query, falling back to listing them: %v", relationship, groupId, err)
+	objects, fallbackErr := fallback()
+	if fallbackErr != nil {
+		return 0, fmt.Errorf("counting %s failed (%v), and listing them also failed: %v", relationship, err, fallbackErr)
+	}
+	if objects == nil {
+		return 0, nil
+	}
+
+	return len(*objects), nil
+}
+
+// groupCounts returns the number of direct members and owners of a group, counted from the provided lists, which are
+// retrieved if nil.
+func groupCounts(ctx context.Context, client *msgraph.GroupsClient, groupId string, members, owners *[]string) (memberCount, ownerCount int, err error) {
+	if members == nil {
+		if members, _, err = client.ListMembers(ctx, groupId); err != nil {
+			err = fmt.Errorf("listing members: %v", err)
+			return
+		}
+	}
+	if members != nil {
+		memberCount = len(*members)
+	}
+
+	if owners == nil {
+		if owners, _, err = client.ListOwners(ctx, groupId); err != nil {
+			err = fmt.Errorf("listing owners: %v", err)
+			return
+		}
+	}
+	if owners != nil {
+		ownerCount = len(*owners)
+	}
+
+	return
+}
+
+// groupTransitiveMemberCount returns the number of transitive members of a group, using an advanced query where
+// permitted in the tenant, so that nested groups do not need to be enumerated.
+func groupTransitiveMemberCount(ctx context.Context, client *msgraph.GroupsClient, groupId string) (int, error) {
+	return groupCountRelationship(ctx, client, groupId, "transitiveMembers", func() (*[]string, error) {
+		result, _, err := groupListTransitiveMembers(ctx, client, groupId)
+		return result, err
+	})
+}
@@ -12,10 +12,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
@@ -69,6 +71,27 @@ func groupsDataSource() *schema.Resource {
 				ExactlyOneOf: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
 			},
 
+			"count_only": {
+				Description:  "Only retrieve the number of groups, without returning the groups themselves. Requires `return_all`",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"return_all"},
+			},
+
+			"eventual_consistency": {
+				Description: "Use an advanced query with eventual consistency when listing groups, which is required by some tenants for filtering and may return results which do not yet reflect recent changes",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"group_count": {
+				Description: "The number of groups found",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"mail_enabled": {
 				Description:   "Whether the groups are mail-enabled",
 				Type:          schema.TypeBool,
@@ -84,10 +107,27 @@ func groupsDataSource() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"object_ids"},
 			},
+
+			"types": {
+				Description:   "A list of group types which the returned groups must have. `Unified` selects Microsoft 365 groups",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"object_ids"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						msgraph.GroupTypeDynamicMembership,
+						msgraph.GroupTypeUnified,
+					}, false),
+				},
+			},
 		},
 	}
 }
 
+// groupsDataSourceSelect is the list of group properties requested from the API
+var groupsDataSourceSelect = []string{"displayName", "id"}
+
 func groupsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.GroupsClient
 	client.BaseClient.DisableRetries = true
@@ -102,6 +142,13 @@ func groupsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		displayNames = v.([]interface{})
 	}
 
+	newQuery := func(query odata.Query) odata.Query {
+		if d.Get("eventual_consistency").(bool) {
+			return helpers.AdvancedQuery(query)
+		}
+		return query
+	}
+
 	var filter []string
 
 	if v, ok := d.GetOkExists("mail_enabled"); ok { //nolint:staticcheck // needed to detect unset booleans
@@ -110,9 +157,26 @@ func groupsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if v, ok := d.GetOkExists("security_enabled"); ok { //nolint:staticcheck // needed to detect unset booleans
 		filter = append(filter, fmt.Sprintf("securityEnabled eq %t", v.(bool)))
 	}
+	for _, v := range tf.ExpandStringSlice(d.Get("types").(*schema.Set).List()) {
+		filter = append(filter, fmt.Sprintf("groupTypes/any(c:c eq '%s')", v))
+	}
+
+	if returnAll && d.Get("count_only").(bool) {
+		count, err := helpers.CountDirectoryObjects(ctx, client.BaseClient, "/groups", strings.Join(filter, " and "))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "count_only", "Could not retrieve the number of groups")
+		}
+
+		d.SetId(fmt.Sprintf("groups#%s#count#%s", client.BaseClient.TenantId, base64.URLEncoding.EncodeToString([]byte(strings.Join(filter, " and ")))))
+		tf.Set(d, "group_count", count)
+		tf.Set(d, "display_names", []string{})
+		tf.Set(d, "object_ids", []string{})
+
+		return nil
+	}
 
 	if returnAll {
-		result, _, err := client.List(ctx, odata.Query{Filter: strings.Join(filter, " and ")})
+		result, _, err := client.List(ctx, newQuery(odata.Query{Filter: strings.Join(filter, " and "), Select: groupsDataSourceSelect}))
 		if err != nil {
 			return tf.ErrorDiagF(err, "Could not retrieve groups")
 		}
@@ -125,7 +189,10 @@ func groupsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 		groups = append(groups, *result...)
 	} else if displayNamePrefix != "" {
-		query := odata.Query{Filter: fmt.Sprintf("startsWith(displayName, '%s')", displayNamePrefix)}
+		query := newQuery(odata.Query{
+			Filter: strings.Join(append(filter, fmt.Sprintf("startsWith(displayName, '%s')", displayNamePrefix)), " and "),
+			Select: groupsDataSourceSelect,
+		})
 		result, _, err := client.List(ctx, query)
 		if err != nil {
 			return tf.ErrorDiagPathF(err, "display_name_prefix", "No groups found with display name prefix: %q", displayNamePrefix)
@@ -142,7 +209,10 @@ func groupsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		expectedCount = len(displayNames)
 		for _, v := range displayNames {
 			displayName := v.(string)
-			query := odata.Query{Filter: strings.Join(append(filter, fmt.Sprintf("displayName eq '%s'", displayName)), " and ")}
+			query := newQuery(odata.Query{
+				Filter: strings.Join(append(filter, fmt.Sprintf("displayName eq '%s'", displayName)), " and "),
+				Select: groupsDataSourceSelect,
+			})
 			result, _, err := client.List(ctx, query)
 			if err != nil {
 				return tf.ErrorDiagPathF(err, "display_names", "No group found with display name: %q", displayName)
@@ -164,7 +234,7 @@ func groupsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		expectedCount = len(objectIds)
 		for _, v := range objectIds {
 			objectId := v.(string)
-			group, status, err := client.Get(ctx, objectId, odata.Query{})
+			group, status, err := client.Get(ctx, objectId, odata.Query{Select: groupsDataSourceSelect})
 			if err != nil {
 				if status == http.StatusNotFound {
 					return tf.ErrorDiagPathF(err, "object_id", "No group found with object ID: %q", objectId)
@@ -204,6 +274,8 @@ func groupsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId("groups#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
 
+	tf.Set(d, "group_count", len(newObjectIds))
+
 	tf.Set(d, "object_ids", newObjectIds)
 	tf.Set(d, "display_names", newDisplayNames)
 	tf.Set(d, "display_name_prefix", displayNamePrefix)
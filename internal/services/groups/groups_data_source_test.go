@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
@@ -47,6 +48,22 @@ func TestAccGroupsDataSource_byDisplayNamePrefix(t *testing.T) {
 	})
 }
 
+func TestAccGroupsDataSource_byDisplayNamePrefixEventualConsistency(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+	r := GroupsDataSource{}
+	moreThanZero := regexp.MustCompile("^[1-9][0-9]*$")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.byDisplayNamePrefixEventualConsistency(data),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("display_names.#").MatchesRegex(moreThanZero),
+				check.That(data.ResourceName).Key("object_ids.#").MatchesRegex(moreThanZero),
+			),
+		},
+	})
+}
+
 func TestAccGroupsDataSource_byObjectIds(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
 	r := GroupsDataSource{}
@@ -90,6 +107,20 @@ func TestAccGroupsDataSource_returnAll(t *testing.T) {
 	})
 }
 
+func TestAccGroupsDataSource_countOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: GroupsDataSource{}.countOnly(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("group_count").Exists(),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("0"),
+			),
+		},
+	})
+}
+
 func TestAccGroupsDataSource_returnAllMailEnabled(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
 
@@ -150,6 +181,52 @@ func TestAccGroupsDataSource_returnAllSecurityNotMailEnabled(t *testing.T) {
 	})
 }
 
+func TestAccGroupsDataSource_returnAllUnified(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: GroupsDataSource{}.returnAllUnified(data),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("display_names.#").Exists(),
+				check.That(data.ResourceName).Key("object_ids.#").Exists(),
+				check.That(data.ResourceName).Key("object_ids").ValidatesWith(testCheckHasOnlyUnifiedGroups()),
+			),
+		},
+	})
+}
+
+func testCheckHasOnlyUnifiedGroups() check.KeyValidationFunc {
+	return func(ctx context.Context, clients *clients.Client, values []interface{}) error {
+		client := clients.Groups.GroupsClient
+
+		for _, v := range values {
+			oid := v.(string)
+			group, _, err := client.Get(ctx, oid, odata.Query{})
+			if err != nil {
+				return fmt.Errorf("retrieving group with object ID %q: %+v", oid, err)
+			}
+			if group == nil || group.ID == nil || group.DisplayName == nil {
+				return fmt.Errorf("retrieving group with object ID %q: group, ID or DisplayName was nil", oid)
+			}
+			if !hasGroupType(group.GroupTypes, msgraph.GroupTypeUnified) {
+				return fmt.Errorf("expected only unified groups, encountered group %q (object ID: %q) which is not a unified group", *group.DisplayName, *group.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func hasGroupType(groupTypes []msgraph.GroupType, groupType msgraph.GroupType) bool {
+	for _, t := range groupTypes {
+		if t == groupType {
+			return true
+		}
+	}
+	return false
+}
+
 func testCheckHasOnlyMailEnabledGroups() check.KeyValidationFunc {
 	return testCheckGroupsDataSource(true, false, false, false)
 }
@@ -248,6 +325,18 @@ data "azuread_groups" "test" {
 `, r.template(data))
 }
 
+func (r GroupsDataSource) byDisplayNamePrefixEventualConsistency(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_groups" "test" {
+  display_name_prefix  = "acctestGroup"
+  eventual_consistency = true
+  depends_on           = [azuread_group.testA, azuread_group.testB]
+}
+`, r.template(data))
+}
+
 func (r GroupsDataSource) byObjectIds(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -274,6 +363,16 @@ data "azuread_groups" "test" {
 `
 }
 
+func (GroupsDataSource) countOnly() string {
+	return `
+data "azuread_groups" "test" {
+  count_only       = true
+  return_all       = true
+  security_enabled = true
+}
+`
+}
+
 func (r GroupsDataSource) returnAllMailEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -319,3 +418,14 @@ data "azuread_groups" "test" {
 }
 `, r.template(data))
 }
+
+func (r GroupsDataSource) returnAllUnified(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_groups" "test" {
+  return_all = true
+  types      = ["Unified"]
+}
+`, r.template(data))
+}
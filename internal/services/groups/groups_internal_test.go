@@ -0,0 +1,104 @@
+package groups
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestGroupMemberBatches(t *testing.T) {
+	cases := []struct {
+		memberCount   int
+		expectedSizes []int
+	}{
+		{
+			memberCount:   0,
+			expectedSizes: []int{},
+		},
+		{
+			memberCount:   1,
+			expectedSizes: []int{1},
+		},
+		{
+			memberCount:   20,
+			expectedSizes: []int{20},
+		},
+		{
+			memberCount:   21,
+			expectedSizes: []int{20, 1},
+		},
+		{
+			memberCount:   350,
+			expectedSizes: []int{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 10},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%d members", tc.memberCount), func(t *testing.T) {
+			members := make(msgraph.Members, 0, tc.memberCount)
+			for i := 0; i < tc.memberCount; i++ {
+				id := fmt.Sprintf("00000000-0000-0000-0000-%012d", i)
+				members = append(members, msgraph.DirectoryObject{
+					ODataId: (*odata.Id)(utils.String(fmt.Sprintf("https://graph.microsoft.com/v1.0/directoryObjects/%s", id))),
+					ID:      utils.String(id),
+				})
+			}
+
+			batches := groupMemberBatches(members, groupMembersBatchSize)
+			if len(batches) != len(tc.expectedSizes) {
+				t.Fatalf("expected %d batches, got %d", len(tc.expectedSizes), len(batches))
+			}
+
+			seen := make(map[string]struct{})
+			for i, batch := range batches {
+				if len(batch) != tc.expectedSizes[i] {
+					t.Fatalf("expected batch %d to contain %d members, got %d", i, tc.expectedSizes[i], len(batch))
+				}
+				for _, member := range batch {
+					if _, ok := seen[*member.ID]; ok {
+						t.Fatalf("member %q was included in more than one batch", *member.ID)
+					}
+					seen[*member.ID] = struct{}{}
+				}
+			}
+
+			if len(seen) != tc.memberCount {
+				t.Fatalf("expected %d members across all batches, got %d", tc.memberCount, len(seen))
+			}
+		})
+	}
+}
+
+func TestGroupValidateMembersManageable(t *testing.T) {
+	cases := []struct {
+		onPremisesSyncEnabled *bool
+		expectError           bool
+	}{
+		{
+			onPremisesSyncEnabled: nil,
+			expectError:           false,
+		},
+		{
+			onPremisesSyncEnabled: utils.Bool(false),
+			expectError:           false,
+		},
+		{
+			onPremisesSyncEnabled: utils.Bool(true),
+			expectError:           true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := groupValidateMembersManageable("00000000-0000-0000-0000-000000000000", tc.onPremisesSyncEnabled)
+		if tc.expectError && err == nil {
+			t.Fatalf("expected an error for onPremisesSyncEnabled %v, got none", tc.onPremisesSyncEnabled)
+		}
+		if !tc.expectError && err != nil {
+			t.Fatalf("expected no error for onPremisesSyncEnabled %v, got: %v", tc.onPremisesSyncEnabled, err)
+		}
+	}
+}
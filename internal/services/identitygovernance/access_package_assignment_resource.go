@@ -0,0 +1,231 @@
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: accessPackageAssignmentResourceCreate,
+		ReadContext:   accessPackageAssignmentResourceRead,
+		DeleteContext: accessPackageAssignmentResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"access_package_id": {
+				Description:      "The ID of the access package to assign",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"assignment_policy_id": {
+				Description:      "The ID of the access package assignment policy under which the assignment is made",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"target_object_id": {
+				Description:      "The object ID of the user or service principal to which the access package is assigned",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"expiration": {
+				Description:  "The date and time at which the assignment expires, formatted as an RFC3339 date string. If omitted, the expiration is determined by the assignment policy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"state": {
+				Description: "The state of the access package assignment",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func accessPackageAssignmentResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	assignmentClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentClient
+
+	accessPackageId := d.Get("access_package_id").(string)
+	targetObjectId := d.Get("target_object_id").(string)
+
+	properties := client.AccessPackageAssignmentRequest{
+		RequestType: utils.String(client.AccessPackageAssignmentRequestTypeAdminAdd),
+		Assignment: &client.AccessPackageAssignment{
+			AccessPackageId:    utils.String(accessPackageId),
+			AssignmentPolicyId: utils.String(d.Get("assignment_policy_id").(string)),
+			TargetId:           utils.String(targetObjectId),
+		},
+	}
+
+	if v, ok := d.GetOk("expiration"); ok {
+		expiration, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "expiration", "Unable to parse the provided expiration %q, must be in RFC3339 format", v)
+		}
+		startDateTime := time.Now().UTC()
+		properties.Schedule = &client.EntitlementManagementSchedule{
+			StartDateTime: &startDateTime,
+			Expiration: &client.ExpirationPattern{
+				EndDateTime: &expiration,
+				Type:        utils.String(client.ExpirationPatternTypeAfterDateTime),
+			},
+		}
+	}
+
+	request, _, err := assignmentClient.CreateRequest(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not request assignment of access package %q to %q", accessPackageId, targetObjectId)
+	}
+	if request.ID == nil || *request.ID == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for access package assignment request is nil/empty")
+	}
+
+	// Assignment requests are processed asynchronously, so wait for delivery to obtain the assignment ID
+	delivered, err := accessPackageAssignmentWaitForRequest(ctx, assignmentClient, *request.ID)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for assignment of access package %q to %q", accessPackageId, targetObjectId)
+	}
+	if delivered.Assignment == nil || delivered.Assignment.ID == nil || *delivered.Assignment.ID == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Assignment returned for delivered access package assignment request %q is nil/empty", *request.ID)
+	}
+
+	d.SetId(*delivered.Assignment.ID)
+
+	return accessPackageAssignmentResourceRead(ctx, d, meta)
+}
+
+func accessPackageAssignmentResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	assignmentClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentClient
+
+	assignment, status, err := assignmentClient.Get(ctx, d.Id(), odata.Query{Expand: odata.Expand{Relationship: "accessPackage,assignmentPolicy,target"}})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package assignment with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving access package assignment with ID: %q", d.Id())
+	}
+
+	if assignment.State != nil && *assignment.State == client.AccessPackageAssignmentStateExpired {
+		log.Printf("[DEBUG] Access package assignment with ID %q has expired - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var accessPackageId, assignmentPolicyId, targetObjectId, expiration *string
+	if assignment.AccessPackage != nil {
+		accessPackageId = assignment.AccessPackage.ID
+	}
+	if assignment.AssignmentPolicy != nil {
+		assignmentPolicyId = assignment.AssignmentPolicy.ID
+	}
+	if assignment.Target != nil {
+		targetObjectId = assignment.Target.ObjectId
+	}
+	if assignment.Schedule != nil && assignment.Schedule.Expiration != nil && assignment.Schedule.Expiration.EndDateTime != nil {
+		expiration = utils.String(assignment.Schedule.Expiration.EndDateTime.Format(time.RFC3339))
+	}
+
+	tf.Set(d, "access_package_id", accessPackageId)
+	tf.Set(d, "assignment_policy_id", assignmentPolicyId)
+	tf.Set(d, "expiration", expiration)
+	tf.Set(d, "state", assignment.State)
+	tf.Set(d, "target_object_id", targetObjectId)
+
+	return nil
+}
+
+func accessPackageAssignmentResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	assignmentClient := meta.(*clients.Client).IdentityGovernance.AccessPackageAssignmentClient
+	assignmentId := d.Id()
+
+	assignment, status, err := assignmentClient.Get(ctx, assignmentId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package assignment with ID %q already deleted", assignmentId)
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving access package assignment with ID %q", assignmentId)
+	}
+	if assignment.State != nil && *assignment.State == client.AccessPackageAssignmentStateExpired {
+		log.Printf("[DEBUG] Access package assignment with ID %q has already expired", assignmentId)
+		return nil
+	}
+
+	request, _, err := assignmentClient.CreateRequest(ctx, client.AccessPackageAssignmentRequest{
+		RequestType: utils.String(client.AccessPackageAssignmentRequestTypeAdminRemove),
+		Assignment: &client.AccessPackageAssignment{
+			ID: utils.String(assignmentId),
+		},
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not request removal of access package assignment with ID %q", assignmentId)
+	}
+	if request.ID == nil || *request.ID == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for access package assignment removal request is nil/empty")
+	}
+
+	if _, err := accessPackageAssignmentWaitForRequest(ctx, assignmentClient, *request.ID); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of access package assignment with ID %q", assignmentId)
+	}
+
+	// Removed assignments are retained for a time in the expired state
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		assignmentClient.BaseClient.DisableRetries = true
+		assignment, status, err := assignmentClient.Get(ctx, assignmentId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return utils.Bool(false), nil
+			}
+			return nil, err
+		}
+		return utils.Bool(assignment.State == nil || *assignment.State != client.AccessPackageAssignmentStateExpired), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of access package assignment with ID %q", assignmentId)
+	}
+
+	return nil
+}
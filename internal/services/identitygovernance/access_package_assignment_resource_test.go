@@ -0,0 +1,120 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AccessPackageAssignmentResource struct{}
+
+// Access packages cannot yet be managed by this provider, so these tests require an existing access package
+// with an assignment policy that permits direct assignment by an administrator
+func (AccessPackageAssignmentResource) preCheck(t *testing.T) (accessPackageId, assignmentPolicyId string) {
+	accessPackageId = os.Getenv("ARM_TEST_ACCESS_PACKAGE_ID")
+	assignmentPolicyId = os.Getenv("ARM_TEST_ACCESS_PACKAGE_ASSIGNMENT_POLICY_ID")
+	if accessPackageId == "" || assignmentPolicyId == "" {
+		t.Skip("ARM_TEST_ACCESS_PACKAGE_ID and ARM_TEST_ACCESS_PACKAGE_ASSIGNMENT_POLICY_ID must be set for access package assignment tests")
+	}
+	return
+}
+
+func TestAccAccessPackageAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	r := AccessPackageAssignmentResource{}
+	accessPackageId, assignmentPolicyId := r.preCheck(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, accessPackageId, assignmentPolicyId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_package_id").HasValue(accessPackageId),
+				check.That(data.ResourceName).Key("assignment_policy_id").HasValue(assignmentPolicyId),
+				check.That(data.ResourceName).Key("state").HasValue("delivered"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageAssignment_expiration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	r := AccessPackageAssignmentResource{}
+	accessPackageId, assignmentPolicyId := r.preCheck(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.expiration(data, accessPackageId, assignmentPolicyId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiration").HasValue("2099-01-01T00:00:00Z"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AccessPackageAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.AccessPackageAssignmentClient
+	client.BaseClient.DisableRetries = true
+
+	assignment, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Access package assignment with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve access package assignment with ID %q: %+v", state.ID, err)
+	}
+	return utils.Bool(assignment.ID != nil && *assignment.ID == state.ID && (assignment.State == nil || *assignment.State != "expired")), nil
+}
+
+func (AccessPackageAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r AccessPackageAssignmentResource) basic(data acceptance.TestData, accessPackageId, assignmentPolicyId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment" "test" {
+  access_package_id    = "%[2]s"
+  assignment_policy_id = "%[3]s"
+  target_object_id     = azuread_user.test.object_id
+}
+`, r.template(data), accessPackageId, assignmentPolicyId)
+}
+
+func (r AccessPackageAssignmentResource) expiration(data acceptance.TestData, accessPackageId, assignmentPolicyId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment" "test" {
+  access_package_id    = "%[2]s"
+  assignment_policy_id = "%[3]s"
+  target_object_id     = azuread_user.test.object_id
+  expiration           = "2099-01-01T00:00:00Z"
+}
+`, r.template(data), accessPackageId, assignmentPolicyId)
+}
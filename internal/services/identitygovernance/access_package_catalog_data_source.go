@@ -0,0 +1,130 @@
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageCatalogDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: accessPackageCatalogDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Description:      "The object ID of the access package catalog",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"object_id", "display_name"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Description:      "The display name of the access package catalog",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"object_id", "display_name"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Description: "The description of the access package catalog",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"externally_visible": {
+				Description: "Whether the access packages in this catalog can be requested by users outside the tenant",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"state": {
+				Description: "Whether the access packages in this catalog are available for management, either `published` or `unpublished`",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func accessPackageCatalogDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.AccessPackageCatalogClient
+	client.BaseClient.DisableRetries = true
+
+	var catalog *msgraph.AccessPackageCatalog
+
+	if objectId, ok := d.Get("object_id").(string); ok && objectId != "" {
+		var status int
+		var err error
+		catalog, status, err = client.Get(ctx, objectId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "object_id", "No access package catalog found with object ID: %q", objectId)
+			}
+			return tf.ErrorDiagF(err, "Retrieving access package catalog with object ID: %q", objectId)
+		}
+	} else if displayName, ok := d.Get("display_name").(string); ok && displayName != "" {
+		// All pages of results are retrieved, so that ambiguous matches can be detected
+		query := odata.Query{
+			Filter: fmt.Sprintf("displayName eq '%s'", utils.EscapeSingleQuote(displayName)),
+		}
+		result, _, err := client.List(ctx, query)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing access package catalogs with filter %q", query.Filter)
+		}
+		if result == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+
+		matches := make([]msgraph.AccessPackageCatalog, 0)
+		for _, c := range *result {
+			if c.DisplayName != nil && *c.DisplayName == displayName {
+				matches = append(matches, c)
+			}
+		}
+
+		if count := len(matches); count > 1 {
+			return tf.ErrorDiagPathF(nil, "display_name", "More than one access package catalog found with display name: %q", displayName)
+		} else if count == 0 {
+			return tf.ErrorDiagPathF(nil, "display_name", "No access package catalog found with display name: %q", displayName)
+		}
+
+		catalog = &matches[0]
+	}
+
+	if catalog == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil access package catalog"), "Bad API Response")
+	}
+	if catalog.ID == nil {
+		return tf.ErrorDiagF(errors.New("API returned access package catalog with nil object ID"), "Bad API Response")
+	}
+
+	d.SetId(*catalog.ID)
+
+	tf.Set(d, "description", catalog.Description)
+	tf.Set(d, "display_name", catalog.DisplayName)
+	tf.Set(d, "externally_visible", catalog.IsExternallyVisible)
+	tf.Set(d, "object_id", catalog.ID)
+	tf.Set(d, "state", catalog.State)
+
+	return nil
+}
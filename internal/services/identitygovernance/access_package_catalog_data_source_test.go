@@ -0,0 +1,81 @@
+package identitygovernance_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AccessPackageCatalogDataSource struct{}
+
+func TestAccAccessPackageCatalogDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package_catalog", "test")
+	r := AccessPackageCatalogDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.byDisplayName(),
+		Check:  r.testCheckFunc(data),
+	}})
+}
+
+func TestAccAccessPackageCatalogDataSource_byObjectId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package_catalog", "test")
+	r := AccessPackageCatalogDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.byObjectId(),
+		Check:  r.testCheckFunc(data),
+	}})
+}
+
+func TestAccAccessPackageCatalogDataSource_nonexistent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package_catalog", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config:      AccessPackageCatalogDataSource{}.nonexistent(data),
+		ExpectError: regexp.MustCompile("No access package catalog found with display name"),
+	}})
+}
+
+func (AccessPackageCatalogDataSource) testCheckFunc(data acceptance.TestData) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		check.That(data.ResourceName).Key("object_id").IsUuid(),
+		check.That(data.ResourceName).Key("display_name").HasValue("General"),
+		check.That(data.ResourceName).Key("externally_visible").Exists(),
+		check.That(data.ResourceName).Key("state").Exists(),
+	)
+}
+
+// Every tenant with entitlement management has a built-in catalog named "General"
+func (AccessPackageCatalogDataSource) byDisplayName() string {
+	return `
+data "azuread_access_package_catalog" "test" {
+  display_name = "General"
+}
+`
+}
+
+func (AccessPackageCatalogDataSource) byObjectId() string {
+	return `
+data "azuread_access_package_catalog" "general" {
+  display_name = "General"
+}
+
+data "azuread_access_package_catalog" "test" {
+  object_id = data.azuread_access_package_catalog.general.object_id
+}
+`
+}
+
+func (AccessPackageCatalogDataSource) nonexistent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_access_package_catalog" "test" {
+  display_name = "acctestCatalog-%[1]d"
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+const (
+	AccessPackageAssignmentRequestTypeAdminAdd    = "adminAdd"
+	AccessPackageAssignmentRequestTypeAdminRemove = "adminRemove"
+
+	AccessPackageAssignmentRequestStateCanceled       = "canceled"
+	AccessPackageAssignmentRequestStateDelivered      = "delivered"
+	AccessPackageAssignmentRequestStateDeliveryFailed = "deliveryFailed"
+	AccessPackageAssignmentRequestStateDenied         = "denied"
+
+	AccessPackageAssignmentStateExpired = "expired"
+
+	ExpirationPatternTypeAfterDateTime = "afterDateTime"
+)
+
+// AccessPackageAssignment describes the assignment of an access package to a subject.
+// This is not yet modelled by the Hamilton SDK.
+type AccessPackageAssignment struct {
+	ID                 *string                         `json:"id,omitempty"`
+	AccessPackageId    *string                         `json:"accessPackageId,omitempty"`
+	AssignmentPolicyId *string                         `json:"assignmentPolicyId,omitempty"`
+	TargetId           *string                         `json:"targetId,omitempty"`
+	Schedule           *EntitlementManagementSchedule  `json:"schedule,omitempty"`
+	State              *string                         `json:"state,omitempty"`
+	Status             *string                         `json:"status,omitempty"`
+	AccessPackage      *AccessPackageAssignmentRelated `json:"accessPackage,omitempty"`
+	AssignmentPolicy   *AccessPackageAssignmentRelated `json:"assignmentPolicy,omitempty"`
+	Target             *AccessPackageSubject           `json:"target,omitempty"`
+}
+
+// AccessPackageAssignmentRelated is an expanded reference to an access package or assignment policy
+type AccessPackageAssignmentRelated struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// AccessPackageSubject describes the user or service principal to which an access package is assigned
+type AccessPackageSubject struct {
+	ID       *string `json:"id,omitempty"`
+	ObjectId *string `json:"objectId,omitempty"`
+}
+
+// AccessPackageAssignmentRequest is a request to create or remove an access package assignment
+type AccessPackageAssignmentRequest struct {
+	ID          *string                        `json:"id,omitempty"`
+	RequestType *string                        `json:"requestType,omitempty"`
+	State       *string                        `json:"state,omitempty"`
+	Status      *string                        `json:"status,omitempty"`
+	Assignment  *AccessPackageAssignment       `json:"assignment,omitempty"`
+	Schedule    *EntitlementManagementSchedule `json:"schedule,omitempty"`
+}
+
+type EntitlementManagementSchedule struct {
+	StartDateTime *time.Time         `json:"startDateTime,omitempty"`
+	Expiration    *ExpirationPattern `json:"expiration,omitempty"`
+}
+
+type ExpirationPattern struct {
+	EndDateTime *time.Time `json:"endDateTime,omitempty"`
+	Type        *string    `json:"type,omitempty"`
+}
+
+type AccessPackageAssignmentClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewAccessPackageAssignmentClient(tenantId string) *AccessPackageAssignmentClient {
+	return &AccessPackageAssignmentClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Get retrieves an AccessPackageAssignment.
+func (c *AccessPackageAssignmentClient) Get(ctx context.Context, id string, query odata.Query) (*AccessPackageAssignment, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/entitlementManagement/assignments/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AccessPackageAssignmentClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var assignment AccessPackageAssignment
+	if err := json.Unmarshal(respBody, &assignment); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &assignment, status, nil
+}
+
+// CreateRequest submits a new AccessPackageAssignmentRequest. Requests are processed asynchronously.
+func (c *AccessPackageAssignmentClient) CreateRequest(ctx context.Context, request AccessPackageAssignmentRequest) (*AccessPackageAssignmentRequest, int, error) {
+	var status int
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/identityGovernance/entitlementManagement/assignmentRequests",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AccessPackageAssignmentClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newRequest AccessPackageAssignmentRequest
+	if err := json.Unmarshal(respBody, &newRequest); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newRequest, status, nil
+}
+
+// GetRequest retrieves an AccessPackageAssignmentRequest.
+func (c *AccessPackageAssignmentClient) GetRequest(ctx context.Context, id string, query odata.Query) (*AccessPackageAssignmentRequest, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/entitlementManagement/assignmentRequests/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AccessPackageAssignmentClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var request AccessPackageAssignmentRequest
+	if err := json.Unmarshal(respBody, &request); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &request, status, nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	AccessPackageAssignmentClient *AccessPackageAssignmentClient
+	AccessPackageCatalogClient    *msgraph.AccessPackageCatalogClient
+	ConnectedOrganizationClient   *ConnectedOrganizationClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	accessPackageAssignmentClient := NewAccessPackageAssignmentClient(o.TenantID)
+	o.ConfigureClient(&accessPackageAssignmentClient.BaseClient)
+
+	accessPackageCatalogClient := msgraph.NewAccessPackageCatalogClient(o.TenantID)
+	o.ConfigureClient(&accessPackageCatalogClient.BaseClient)
+
+	connectedOrganizationClient := NewConnectedOrganizationClient(o.TenantID)
+	o.ConfigureClient(&connectedOrganizationClient.BaseClient)
+
+	return &Client{
+		AccessPackageAssignmentClient: accessPackageAssignmentClient,
+		AccessPackageCatalogClient:    accessPackageCatalogClient,
+		ConnectedOrganizationClient:   connectedOrganizationClient,
+	}
+}
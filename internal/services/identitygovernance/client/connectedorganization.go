@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+const (
+	ConnectedOrganizationStateConfigured = "configured"
+	ConnectedOrganizationStateProposed   = "proposed"
+
+	IdentitySourceTypeAzureActiveDirectoryTenant odata.Type = "#microsoft.graph.azureActiveDirectoryTenant"
+	IdentitySourceTypeDomainIdentitySource       odata.Type = "#microsoft.graph.domainIdentitySource"
+)
+
+// ConnectedOrganization describes an external organization with which users can collaborate via entitlement management.
+// This is not yet modelled by the Hamilton SDK.
+type ConnectedOrganization struct {
+	ID              *string           `json:"id,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	DisplayName     *string           `json:"displayName,omitempty"`
+	IdentitySources *[]IdentitySource `json:"identitySources,omitempty"`
+	State           *string           `json:"state,omitempty"`
+}
+
+// IdentitySource describes either an Azure AD tenant or a domain belonging to a connected organization
+type IdentitySource struct {
+	ODataType   *odata.Type `json:"@odata.type,omitempty"`
+	DisplayName *string     `json:"displayName,omitempty"`
+	DomainName  *string     `json:"domainName,omitempty"`
+	TenantId    *string     `json:"tenantId,omitempty"`
+}
+
+type ConnectedOrganizationClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewConnectedOrganizationClient(tenantId string) *ConnectedOrganizationClient {
+	return &ConnectedOrganizationClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create creates a new ConnectedOrganization.
+func (c *ConnectedOrganizationClient) Create(ctx context.Context, connectedOrganization ConnectedOrganization) (*ConnectedOrganization, int, error) {
+	var status int
+	body, err := json.Marshal(connectedOrganization)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/identityGovernance/entitlementManagement/connectedOrganizations",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectedOrganizationClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newConnectedOrganization ConnectedOrganization
+	if err := json.Unmarshal(respBody, &newConnectedOrganization); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newConnectedOrganization, status, nil
+}
+
+// Get retrieves a ConnectedOrganization.
+func (c *ConnectedOrganizationClient) Get(ctx context.Context, id string, query odata.Query) (*ConnectedOrganization, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/entitlementManagement/connectedOrganizations/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectedOrganizationClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var connectedOrganization ConnectedOrganization
+	if err := json.Unmarshal(respBody, &connectedOrganization); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &connectedOrganization, status, nil
+}
+
+// Update amends an existing ConnectedOrganization. Identity sources cannot be changed once created.
+func (c *ConnectedOrganizationClient) Update(ctx context.Context, connectedOrganization ConnectedOrganization) (int, error) {
+	var status int
+
+	if connectedOrganization.ID == nil {
+		return status, errors.New("cannot update connectedOrganization with nil ID")
+	}
+
+	body, err := json.Marshal(connectedOrganization)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/entitlementManagement/connectedOrganizations/%s", *connectedOrganization.ID),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConnectedOrganizationClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes a ConnectedOrganization.
+func (c *ConnectedOrganizationClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/entitlementManagement/connectedOrganizations/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConnectedOrganizationClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,199 @@
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func connectedOrganizationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: connectedOrganizationResourceCreate,
+		ReadContext:   connectedOrganizationResourceRead,
+		UpdateContext: connectedOrganizationResourceUpdate,
+		DeleteContext: connectedOrganizationResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Description:      "The display name of the connected organization",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Description: "The description of the connected organization",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"domain_names": {
+				Description:  "A set of domain names belonging to the connected organization",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Set:          schema.HashString,
+				AtLeastOneOf: []string{"domain_names", "tenant_ids"},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"state": {
+				Description: "The state of the connected organization, either `configured` or `proposed`",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     client.ConnectedOrganizationStateConfigured,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.ConnectedOrganizationStateConfigured,
+					client.ConnectedOrganizationStateProposed,
+				}, false),
+			},
+
+			"tenant_ids": {
+				Description:  "A set of Azure Active Directory tenant IDs belonging to the connected organization",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Set:          schema.HashString,
+				AtLeastOneOf: []string{"domain_names", "tenant_ids"},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+
+			"object_id": {
+				Description: "The object ID of the connected organization",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func connectedOrganizationResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	identitySources := expandConnectedOrganizationIdentitySources(d.Get("tenant_ids").(*schema.Set).List(), d.Get("domain_names").(*schema.Set).List())
+	if len(identitySources) == 0 {
+		return tf.ErrorDiagF(errors.New("at least one of `domain_names` or `tenant_ids` must be specified"), "Could not create connected organization")
+	}
+
+	properties := expandConnectedOrganization(d)
+	properties.IdentitySources = &identitySources
+
+	connectedOrganization, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create connected organization")
+	}
+	if connectedOrganization.ID == nil || *connectedOrganization.ID == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for connected organization is nil/empty")
+	}
+
+	d.SetId(*connectedOrganization.ID)
+
+	return connectedOrganizationResourceRead(ctx, d, meta)
+}
+
+func connectedOrganizationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	properties := expandConnectedOrganization(d)
+	properties.ID = utils.String(d.Id())
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update connected organization with object ID: %q", d.Id())
+	}
+
+	return connectedOrganizationResourceRead(ctx, d, meta)
+}
+
+func connectedOrganizationResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	connectedOrganization, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Connected organization with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving connected organization with object ID: %q", d.Id())
+	}
+
+	tenantIds, domainNames := flattenConnectedOrganizationIdentitySources(connectedOrganization.IdentitySources)
+
+	tf.Set(d, "description", connectedOrganization.Description)
+	tf.Set(d, "display_name", connectedOrganization.DisplayName)
+	tf.Set(d, "domain_names", domainNames)
+	tf.Set(d, "object_id", connectedOrganization.ID)
+	tf.Set(d, "state", connectedOrganization.State)
+	tf.Set(d, "tenant_ids", tenantIds)
+
+	return nil
+}
+
+func connectedOrganizationResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+	connectedOrganizationId := d.Id()
+
+	if _, status, err := client.Get(ctx, connectedOrganizationId, odata.Query{}); err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Connected organization with ID %q already deleted", connectedOrganizationId)
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving connected organization with object ID %q", connectedOrganizationId)
+	}
+
+	if _, err := client.Delete(ctx, connectedOrganizationId); err != nil {
+		return tf.ErrorDiagF(err, "Deleting connected organization with object ID: %q", connectedOrganizationId)
+	}
+
+	// Wait for connected organization to be deleted
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		client.BaseClient.DisableRetries = true
+		if _, status, err := client.Get(ctx, connectedOrganizationId, odata.Query{}); err != nil {
+			if status == http.StatusNotFound {
+				return utils.Bool(false), nil
+			}
+			return nil, err
+		}
+		return utils.Bool(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of connected organization with object ID %q", connectedOrganizationId)
+	}
+
+	return nil
+}
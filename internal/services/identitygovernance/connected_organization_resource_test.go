@@ -0,0 +1,104 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ConnectedOrganizationResource struct{}
+
+func TestAccConnectedOrganization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("object_id").IsUuid(),
+				check.That(data.ResourceName).Key("state").HasValue("configured"),
+				check.That(data.ResourceName).Key("tenant_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConnectedOrganization_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue(fmt.Sprintf("Connected organization %d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("state").HasValue("proposed"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").IsEmpty(),
+				check.That(data.ResourceName).Key("state").HasValue("configured"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ConnectedOrganizationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.ConnectedOrganizationClient
+	client.BaseClient.DisableRetries = true
+
+	connectedOrganization, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Connected organization with object ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve connected organization with object ID %q: %+v", state.ID, err)
+	}
+	return utils.Bool(connectedOrganization.ID != nil && *connectedOrganization.ID == state.ID), nil
+}
+
+func (ConnectedOrganizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_connected_organization" "test" {
+  display_name = "acctestConnectedOrganization-%[1]d"
+  tenant_ids   = ["72f988bf-86f1-41af-91ab-2d7cd011db47"]
+}
+`, data.RandomInteger)
+}
+
+func (ConnectedOrganizationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_connected_organization" "test" {
+  display_name = "acctestConnectedOrganization-%[1]d"
+  description  = "Connected organization %[1]d"
+  state        = "proposed"
+  tenant_ids   = ["72f988bf-86f1-41af-91ab-2d7cd011db47"]
+}
+`, data.RandomInteger)
+}
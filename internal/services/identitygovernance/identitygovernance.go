@@ -0,0 +1,123 @@
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func expandConnectedOrganization(d *schema.ResourceData) client.ConnectedOrganization {
+	return client.ConnectedOrganization{
+		Description: utils.String(d.Get("description").(string)),
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		State:       utils.String(d.Get("state").(string)),
+	}
+}
+
+func expandConnectedOrganizationIdentitySources(tenantIds, domainNames []interface{}) []client.IdentitySource {
+	result := make([]client.IdentitySource, 0)
+
+	for _, v := range tenantIds {
+		odataType := client.IdentitySourceTypeAzureActiveDirectoryTenant
+		tenantId := v.(string)
+		result = append(result, client.IdentitySource{
+			ODataType:   &odataType,
+			DisplayName: &tenantId,
+			TenantId:    &tenantId,
+		})
+	}
+
+	for _, v := range domainNames {
+		odataType := client.IdentitySourceTypeDomainIdentitySource
+		domainName := v.(string)
+		result = append(result, client.IdentitySource{
+			ODataType:   &odataType,
+			DisplayName: &domainName,
+			DomainName:  &domainName,
+		})
+	}
+
+	return result
+}
+
+func flattenConnectedOrganizationIdentitySources(in *[]client.IdentitySource) (tenantIds, domainNames []interface{}) {
+	tenantIds = make([]interface{}, 0)
+	domainNames = make([]interface{}, 0)
+
+	if in == nil {
+		return
+	}
+
+	for _, source := range *in {
+		if source.ODataType == nil {
+			continue
+		}
+		switch *source.ODataType {
+		case client.IdentitySourceTypeAzureActiveDirectoryTenant:
+			if source.TenantId != nil {
+				tenantIds = append(tenantIds, *source.TenantId)
+			}
+		case client.IdentitySourceTypeDomainIdentitySource:
+			if source.DomainName != nil {
+				domainNames = append(domainNames, *source.DomainName)
+			}
+		}
+	}
+
+	return
+}
+
+// accessPackageAssignmentWaitForRequest polls an assignment request until it has been delivered, returning an error if it
+// is canceled, denied or fails to be delivered
+func accessPackageAssignmentWaitForRequest(ctx context.Context, assignmentClient *client.AccessPackageAssignmentClient, requestId string) (*client.AccessPackageAssignmentRequest, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, errors.New("context has no deadline")
+	}
+
+	result, err := (&resource.StateChangeConf{
+		Pending:    []string{"Waiting"},
+		Target:     []string{client.AccessPackageAssignmentRequestStateDelivered},
+		Timeout:    time.Until(deadline),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			request, status, err := assignmentClient.GetRequest(ctx, requestId, odata.Query{Expand: odata.Expand{Relationship: "assignment"}})
+			if err != nil {
+				if status == http.StatusNotFound {
+					return nil, "Waiting", nil
+				}
+				return nil, "Error", fmt.Errorf("retrieving assignment request with ID %q: %+v", requestId, err)
+			}
+			if request == nil || request.State == nil {
+				return nil, "Waiting", nil
+			}
+
+			switch state := *request.State; state {
+			case client.AccessPackageAssignmentRequestStateDelivered:
+				return request, state, nil
+			case client.AccessPackageAssignmentRequestStateCanceled, client.AccessPackageAssignmentRequestStateDeliveryFailed, client.AccessPackageAssignmentRequestStateDenied:
+				requestStatus := ""
+				if request.Status != nil {
+					requestStatus = *request.Status
+				}
+				return nil, "Error", fmt.Errorf("assignment request with ID %q was not delivered (state: %q, status: %q)", requestId, state, requestStatus)
+			}
+
+			return request, "Waiting", nil
+		},
+	}).WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*client.AccessPackageAssignmentRequest), nil
+}
@@ -0,0 +1,210 @@
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+const authorizationPolicyId = "authorizationPolicy"
+
+func authorizationPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: authorizationPolicyResourceCreate,
+		ReadContext:   authorizationPolicyResourceRead,
+		UpdateContext: authorizationPolicyResourceUpdate,
+		DeleteContext: authorizationPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != authorizationPolicyId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, authorizationPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"allow_email_verified_users_to_join_organization": {
+				Description: "Whether a user can join the tenant by email validation",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allow_invites_from": {
+				Description: "Which users can invite guests to the tenant",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.AllowInvitesFromNone,
+					client.AllowInvitesFromAdminsAndGuestInviters,
+					client.AllowInvitesFromAdminsGuestInvitersAndAllMembers,
+					client.AllowInvitesFromEveryone,
+				}, false),
+			},
+
+			"allowed_to_sign_up_email_based_subscriptions": {
+				Description: "Whether users can sign up for email based subscriptions",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allowed_to_use_sspr": {
+				Description: "Whether users can use the Self-Service Password Reset feature on the tenant",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"block_msol_powershell": {
+				Description: "Whether to block access to the legacy MSOnline PowerShell module for non-admin users",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"default_user_role_permissions": {
+				Description: "Permissions granted to the default user role",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_to_create_apps": {
+							Description: "Whether the default user role can create applications",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+
+						"allowed_to_create_security_groups": {
+							Description: "Whether the default user role can create security groups",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+
+						"allowed_to_read_other_users": {
+							Description: "Whether the default user role can read other users",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+
+			"guest_user_role_id": {
+				Description: "The ID of the role that guest users are assigned, which determines the level of access granted to guest users",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.GuestUserRoleIdUser,
+					client.GuestUserRoleIdGuest,
+					client.GuestUserRoleIdRestrictedGuest,
+				}, false),
+			},
+
+			"display_name": {
+				Description: "The display name of the authorization policy",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func authorizationPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	// There is exactly one authorization policy per tenant, so we adopt the existing policy
+	policy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving authorization policy")
+	}
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("API returned authorization policy with nil ID"), "Bad API Response")
+	}
+
+	d.SetId(*policy.ID)
+
+	return authorizationPolicyResourceUpdate(ctx, d, meta)
+}
+
+func authorizationPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	if _, err := client.Update(ctx, expandAuthorizationPolicy(d)); err != nil {
+		return tf.ErrorDiagF(err, "Could not update authorization policy")
+	}
+
+	return authorizationPolicyResourceRead(ctx, d, meta)
+}
+
+func authorizationPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	policy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving authorization policy")
+	}
+
+	tf.Set(d, "allow_email_verified_users_to_join_organization", policy.AllowEmailVerifiedUsersToJoinOrganization)
+	tf.Set(d, "allow_invites_from", policy.AllowInvitesFrom)
+	tf.Set(d, "allowed_to_sign_up_email_based_subscriptions", policy.AllowedToSignUpEmailBasedSubscriptions)
+	tf.Set(d, "allowed_to_use_sspr", policy.AllowedToUseSSPR)
+	tf.Set(d, "block_msol_powershell", policy.BlockMsolPowerShell)
+	tf.Set(d, "default_user_role_permissions", flattenDefaultUserRolePermissions(policy.DefaultUserRolePermissions))
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "guest_user_role_id", policy.GuestUserRoleId)
+
+	return nil
+}
+
+func authorizationPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	// The authorization policy cannot be deleted, so instead we reset it to the default settings for a new tenant
+	if _, err := client.Update(ctx, defaultAuthorizationPolicy()); err != nil {
+		return tf.ErrorDiagF(err, "Could not reset authorization policy to default settings")
+	}
+
+	return nil
+}
+
+func defaultAuthorizationPolicy() client.AuthorizationPolicy {
+	return client.AuthorizationPolicy{
+		AllowEmailVerifiedUsersToJoinOrganization: utils.Bool(true),
+		AllowInvitesFrom:                       utils.String(client.AllowInvitesFromEveryone),
+		AllowedToSignUpEmailBasedSubscriptions: utils.Bool(true),
+		AllowedToUseSSPR:                       utils.Bool(true),
+		BlockMsolPowerShell:                    utils.Bool(false),
+		DefaultUserRolePermissions: &client.DefaultUserRolePermissions{
+			AllowedToCreateApps:           utils.Bool(true),
+			AllowedToCreateSecurityGroups: utils.Bool(true),
+			AllowedToReadOtherUsers:       utils.Bool(true),
+		},
+		GuestUserRoleId: utils.String(client.GuestUserRoleIdGuest),
+	}
+}
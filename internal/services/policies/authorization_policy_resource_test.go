@@ -0,0 +1,93 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AuthorizationPolicyResource struct{}
+
+// The authorization policy is a singleton, so all steps are contained in a single test to avoid conflicting changes
+func TestAccAuthorizationPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authorization_policy", "test")
+	r := AuthorizationPolicyResource{}
+
+	data.ResourceTestIgnoreDangling(t, r, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("adminsAndGuestInviters"),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("none"),
+				check.That(data.ResourceName).Key("block_msol_powershell").HasValue("true"),
+				check.That(data.ResourceName).Key("default_user_role_permissions.0.allowed_to_create_apps").HasValue("false"),
+				check.That(data.ResourceName).Key("guest_user_role_id").HasValue("2af84b1e-32c8-42b7-82bc-daa82404023b"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("adminsAndGuestInviters"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AuthorizationPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthorizationPolicyClient
+	client.BaseClient.DisableRetries = true
+
+	policy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve authorization policy: %+v", err)
+	}
+	return utils.Bool(policy.ID != nil && *policy.ID == state.ID), nil
+}
+
+func (AuthorizationPolicyResource) basic() string {
+	return `
+resource "azuread_authorization_policy" "test" {
+  allow_invites_from = "adminsAndGuestInviters"
+}
+`
+}
+
+func (AuthorizationPolicyResource) complete() string {
+	return `
+resource "azuread_authorization_policy" "test" {
+  allow_email_verified_users_to_join_organization = false
+  allow_invites_from                              = "none"
+  allowed_to_sign_up_email_based_subscriptions    = false
+  allowed_to_use_sspr                             = true
+  block_msol_powershell                           = true
+  guest_user_role_id                              = "2af84b1e-32c8-42b7-82bc-daa82404023b"
+
+  default_user_role_permissions {
+    allowed_to_create_apps            = false
+    allowed_to_create_security_groups = false
+    allowed_to_read_other_users       = true
+  }
+}
+`
+}
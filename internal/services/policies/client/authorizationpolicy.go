@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+const (
+	AllowInvitesFromNone                             = "none"
+	AllowInvitesFromAdminsAndGuestInviters           = "adminsAndGuestInviters"
+	AllowInvitesFromAdminsGuestInvitersAndAllMembers = "adminsGuestInvitersAndAllMembers"
+	AllowInvitesFromEveryone                         = "everyone"
+
+	// GuestUserRoleIdUser grants guest users the same access as members
+	GuestUserRoleIdUser = "a0b1b346-4d3e-4e8b-98f8-753987be4970"
+
+	// GuestUserRoleIdGuest grants guest users limited access to properties and memberships of directory objects
+	GuestUserRoleIdGuest = "10dae51f-b6af-4016-8d66-8c2a99b929b3"
+
+	// GuestUserRoleIdRestrictedGuest restricts guest users to properties and memberships of their own directory objects
+	GuestUserRoleIdRestrictedGuest = "2af84b1e-32c8-42b7-82bc-daa82404023b"
+)
+
+// AuthorizationPolicy describes the tenant-wide authorization settings, of which there is exactly one per tenant.
+// This is not yet modelled by the Hamilton SDK.
+type AuthorizationPolicy struct {
+	ID                                        *string                     `json:"id,omitempty"`
+	AllowEmailVerifiedUsersToJoinOrganization *bool                       `json:"allowEmailVerifiedUsersToJoinOrganization,omitempty"`
+	AllowInvitesFrom                          *string                     `json:"allowInvitesFrom,omitempty"`
+	AllowedToSignUpEmailBasedSubscriptions    *bool                       `json:"allowedToSignUpEmailBasedSubscriptions,omitempty"`
+	AllowedToUseSSPR                          *bool                       `json:"allowedToUseSSPR,omitempty"`
+	BlockMsolPowerShell                       *bool                       `json:"blockMsolPowerShell,omitempty"`
+	DefaultUserRolePermissions                *DefaultUserRolePermissions `json:"defaultUserRolePermissions,omitempty"`
+	Description                               *string                     `json:"description,omitempty"`
+	DisplayName                               *string                     `json:"displayName,omitempty"`
+	GuestUserRoleId                           *string                     `json:"guestUserRoleId,omitempty"`
+}
+
+// DefaultUserRolePermissions describes the permissions granted to the default user role
+type DefaultUserRolePermissions struct {
+	AllowedToCreateApps           *bool `json:"allowedToCreateApps,omitempty"`
+	AllowedToCreateSecurityGroups *bool `json:"allowedToCreateSecurityGroups,omitempty"`
+	AllowedToReadOtherUsers       *bool `json:"allowedToReadOtherUsers,omitempty"`
+}
+
+type AuthorizationPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewAuthorizationPolicyClient(tenantId string) *AuthorizationPolicyClient {
+	return &AuthorizationPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Get retrieves the AuthorizationPolicy for the tenant.
+func (c *AuthorizationPolicyClient) Get(ctx context.Context, query odata.Query) (*AuthorizationPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/authorizationPolicy",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthorizationPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var authorizationPolicy AuthorizationPolicy
+	if err := json.Unmarshal(respBody, &authorizationPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &authorizationPolicy, status, nil
+}
+
+// Update amends the AuthorizationPolicy for the tenant.
+func (c *AuthorizationPolicyClient) Update(ctx context.Context, authorizationPolicy AuthorizationPolicy) (int, error) {
+	var status int
+
+	// The ID is read-only and should not be sent when updating the policy
+	authorizationPolicy.ID = nil
+
+	body, err := json.Marshal(authorizationPolicy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/authorizationPolicy",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthorizationPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
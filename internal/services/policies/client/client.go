@@ -0,0 +1,18 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	AuthorizationPolicyClient *AuthorizationPolicyClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	authorizationPolicyClient := NewAuthorizationPolicyClient(o.TenantID)
+	o.ConfigureClient(&authorizationPolicyClient.BaseClient)
+
+	return &Client{
+		AuthorizationPolicyClient: authorizationPolicyClient,
+	}
+}
@@ -0,0 +1,68 @@
+package policies
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+// expandAuthorizationPolicy builds an AuthorizationPolicy containing the configured settings. Settings which are not
+// configured are omitted, so that their existing values are retained.
+func expandAuthorizationPolicy(d *schema.ResourceData) client.AuthorizationPolicy {
+	var policy client.AuthorizationPolicy
+
+	if v, ok := d.GetOkExists("allow_email_verified_users_to_join_organization"); ok { //nolint:staticcheck
+		policy.AllowEmailVerifiedUsersToJoinOrganization = utils.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("allow_invites_from"); ok {
+		policy.AllowInvitesFrom = utils.String(v.(string))
+	}
+	if v, ok := d.GetOkExists("allowed_to_sign_up_email_based_subscriptions"); ok { //nolint:staticcheck
+		policy.AllowedToSignUpEmailBasedSubscriptions = utils.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("allowed_to_use_sspr"); ok { //nolint:staticcheck
+		policy.AllowedToUseSSPR = utils.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("block_msol_powershell"); ok { //nolint:staticcheck
+		policy.BlockMsolPowerShell = utils.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("default_user_role_permissions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in := v.([]interface{})[0].(map[string]interface{})
+		policy.DefaultUserRolePermissions = &client.DefaultUserRolePermissions{
+			AllowedToCreateApps:           utils.Bool(in["allowed_to_create_apps"].(bool)),
+			AllowedToCreateSecurityGroups: utils.Bool(in["allowed_to_create_security_groups"].(bool)),
+			AllowedToReadOtherUsers:       utils.Bool(in["allowed_to_read_other_users"].(bool)),
+		}
+	}
+	if v, ok := d.GetOk("guest_user_role_id"); ok {
+		policy.GuestUserRoleId = utils.String(v.(string))
+	}
+
+	return policy
+}
+
+func flattenDefaultUserRolePermissions(in *client.DefaultUserRolePermissions) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	allowedToCreateApps := false
+	if in.AllowedToCreateApps != nil {
+		allowedToCreateApps = *in.AllowedToCreateApps
+	}
+	allowedToCreateSecurityGroups := false
+	if in.AllowedToCreateSecurityGroups != nil {
+		allowedToCreateSecurityGroups = *in.AllowedToCreateSecurityGroups
+	}
+	allowedToReadOtherUsers := false
+	if in.AllowedToReadOtherUsers != nil {
+		allowedToReadOtherUsers = *in.AllowedToReadOtherUsers
+	}
+
+	return []map[string]interface{}{{
+		"allowed_to_create_apps":            allowedToCreateApps,
+		"allowed_to_create_security_groups": allowedToCreateSecurityGroups,
+		"allowed_to_read_other_users":       allowedToReadOtherUsers,
+	}}
+}
@@ -44,6 +44,10 @@ func clientConfigDataSource() *schema.Resource {
 
 func clientConfigDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client)
+	if err := client.LoadClaims(); err != nil {
+		return tf.ErrorDiagF(err, "Could not obtain claims for the authenticated principal")
+	}
+
 	d.SetId(fmt.Sprintf("%s-%s-%s", client.TenantID, client.ClientID, client.Claims.ObjectId))
 	tf.Set(d, "tenant_id", client.TenantID)
 	tf.Set(d, "client_id", client.ClientID)
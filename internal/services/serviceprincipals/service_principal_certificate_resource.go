@@ -93,7 +93,7 @@ func servicePrincipalCertificateResource() *schema.Resource {
 			},
 
 			"end_date_relative": {
-				Description:      "A relative duration for which the certificate is valid until, for example `240h` (10 days) or `2400h30m`. Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\"",
+				Description:      "A relative duration for which the certificate is valid until, for example `240h` (10 days), `2400h30m`, `90d` or `1y`",
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
@@ -84,7 +84,7 @@ func servicePrincipalPasswordResource() *schema.Resource {
 			},
 
 			"end_date_relative": {
-				Description:      "A relative duration for which the password is valid until, for example `240h` (10 days) or `2400h30m`. Changing this field forces a new resource to be created",
+				Description:      "A relative duration for which the password is valid until, for example `240h` (10 days), `2400h30m`, `90d` or `1y`. Changing this field forces a new resource to be created",
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
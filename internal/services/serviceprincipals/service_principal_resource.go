@@ -39,12 +39,14 @@ func servicePrincipalResource() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+		CustomizeDiff: servicePrincipalResourceCustomizeDiff,
+
+		Importer: tf.ValidateResourceIDPriorToImportThen(func(id string) error {
 			if _, err := uuid.ParseUUID(id); err != nil {
 				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
 			}
 			return nil
-		}),
+		}, servicePrincipalResourceImport),
 
 		Schema: map[string]*schema.Schema{
 			"application_id": {
@@ -62,6 +64,13 @@ func servicePrincipalResource() *schema.Resource {
 				Default:     true,
 			},
 
+			"allow_disabling_current_principal": {
+				Description: "Whether to allow disabling the service principal that Terraform is currently authenticated as",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"alternative_names": {
 				Description: "A list of alternative names, used to retrieve service principals by subscription, identify resource group and full resource ids for managed identities",
 				Type:        schema.TypeSet,
@@ -177,7 +186,7 @@ func servicePrincipalResource() *schema.Resource {
 				Optional:    true,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					ValidateDiagFunc: validate.NoEmptyStrings,
+					ValidateDiagFunc: validate.StringIsEmailAddress,
 				},
 			},
 
@@ -332,6 +341,18 @@ func servicePrincipalResource() *schema.Resource {
 	}
 }
 
+func servicePrincipalResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// SAML settings are only applicable when the preferred single sign-on mode is SAML
+	if diff.NewValueKnown("preferred_single_sign_on_mode") {
+		mode := diff.Get("preferred_single_sign_on_mode").(string)
+		if relayState := diff.Get("saml_single_sign_on.0.relay_state").(string); relayState != "" && mode != string(msgraph.PreferredSingleSignOnModeSaml) {
+			return fmt.Errorf("`saml_single_sign_on` can only be specified when `preferred_single_sign_on_mode` is %q", msgraph.PreferredSingleSignOnModeSaml)
+		}
+	}
+
+	return nil
+}
+
 func servicePrincipalDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	suppress := false
 
@@ -353,6 +374,9 @@ func servicePrincipalDiffSuppress(k, old, new string, d *schema.ResourceData) bo
 func servicePrincipalResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalsClient
 	directoryObjectsClient := meta.(*clients.Client).ServicePrincipals.DirectoryObjectsClient
+	if err := meta.(*clients.Client).LoadClaims(); err != nil {
+		return tf.ErrorDiagF(err, "Could not obtain claims for the authenticated principal")
+	}
 	callerId := meta.(*clients.Client).Claims.ObjectId
 
 	appId := d.Get("application_id").(string)
@@ -502,11 +526,26 @@ func servicePrincipalResourceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// Wait for the configured owners to be consistently reflected, since these are read back immediately
+	if err := servicePrincipalWaitForOwners(ctx, client, d.Id(), tf.ExpandStringSlice(d.Get("owners").(*schema.Set).List())); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for owners of service principal with object ID %q to be updated", d.Id())
+	}
+
 	return servicePrincipalResourceRead(ctx, d, meta)
 }
 
 func servicePrincipalResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalsClient
+	if err := meta.(*clients.Client).LoadClaims(); err != nil {
+		return tf.ErrorDiagF(err, "Could not obtain claims for the authenticated principal")
+	}
+	callerId := meta.(*clients.Client).Claims.ObjectId
+
+	// Disabling the service principal that Terraform is authenticated as will cause all subsequent operations to fail
+	if d.HasChange("account_enabled") && !d.Get("account_enabled").(bool) && strings.EqualFold(d.Id(), callerId) && !d.Get("allow_disabling_current_principal").(bool) {
+		d.Partial(true)
+		return tf.ErrorDiagPathF(nil, "account_enabled", "Refusing to disable the service principal that Terraform is currently authenticated as (object ID: %q). To proceed anyway, set `allow_disabling_current_principal = true`", callerId)
+	}
 
 	var tags []string
 	if v, ok := d.GetOk("feature_tags"); ok && len(v.([]interface{})) > 0 && d.HasChange("feature_tags") {
@@ -537,14 +576,18 @@ func servicePrincipalResourceUpdate(ctx context.Context, d *schema.ResourceData,
 		return tf.ErrorDiagF(err, "Updating service principal with object ID: %q", d.Id())
 	}
 
-	if v, ok := d.GetOk("owners"); ok && d.HasChange("owners") {
+	// All owners may be removed, so check for changes rather than whether any owners are configured
+	if d.HasChange("owners") {
 		owners, _, err := client.ListOwners(ctx, d.Id())
 		if err != nil {
 			return tf.ErrorDiagF(err, "Could not retrieve owners for service principal with object ID: %q", d.Id())
 		}
 
-		desiredOwners := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
-		existingOwners := *owners
+		desiredOwners := tf.ExpandStringSlice(d.Get("owners").(*schema.Set).List())
+		existingOwners := make([]string, 0)
+		if owners != nil {
+			existingOwners = *owners
+		}
 		ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
 		ownersToAdd := utils.Difference(desiredOwners, existingOwners)
 
@@ -565,10 +608,19 @@ func servicePrincipalResourceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if len(ownersForRemoval) > 0 {
-			if _, err = client.RemoveOwners(ctx, d.Id(), &ownersForRemoval); err != nil {
+			if status, err := client.RemoveOwners(ctx, d.Id(), &ownersForRemoval); err != nil {
+				if status == http.StatusBadRequest && len(desiredOwners) == 0 {
+					return tf.ErrorDiagPathF(err, "owners", "Could not remove the last owner(s) from service principal with object ID %q. The service principal may be required to retain at least one owner", d.Id())
+				}
 				return tf.ErrorDiagF(err, "Could not remove owners from service principal with object ID: %q", d.Id())
 			}
 		}
+
+		if len(ownersForRemoval) > 0 || len(ownersToAdd) > 0 {
+			if err := servicePrincipalWaitForOwners(ctx, client, d.Id(), desiredOwners); err != nil {
+				return tf.ErrorDiagF(err, "Waiting for owners of service principal with object ID %q to be updated", d.Id())
+			}
+		}
 	}
 
 	return servicePrincipalResourceRead(ctx, d, meta)
@@ -673,3 +725,13 @@ func servicePrincipalResourceDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+func servicePrincipalResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// allow_disabling_current_principal is not returned by the API, so set it to its default value to ensure that the
+	// first plan following an import does not show a diff for it
+	if err := d.Set("allow_disabling_current_principal", false); err != nil {
+		return nil, fmt.Errorf("setting `allow_disabling_current_principal` for imported service principal: %+v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
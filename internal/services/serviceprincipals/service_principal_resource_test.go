@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -104,6 +105,83 @@ func TestAccServicePrincipal_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_appRoleAssignmentRequired(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+	roleId := data.UUID()
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.appRoleAssignmentRequired(data, roleId, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment_required").HasValue("true"),
+				check.That("azuread_app_role_assignment.test").Key("id").Exists(),
+			),
+		},
+		data.ImportStep("use_existing"),
+		{
+			Config: r.appRoleAssignmentRequired(data, roleId, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment_required").HasValue("false"),
+				check.That("azuread_app_role_assignment.test").Key("id").Exists(),
+			),
+		},
+		data.ImportStep("use_existing"),
+		{
+			Config: r.appRoleAssignmentRequired(data, roleId, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment_required").HasValue("true"),
+			),
+		},
+		data.ImportStep("use_existing"),
+	})
+}
+
+func TestAccServicePrincipal_invalidNotificationEmailAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.notificationEmailAddresses(data, "not-an-email-address"),
+			ExpectError: regexp.MustCompile("Value must be a valid email address"),
+		},
+	})
+}
+
+func TestAccServicePrincipal_samlSingleSignOn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.samlSingleSignOn(data, "saml"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("preferred_single_sign_on_mode").HasValue("saml"),
+				check.That(data.ResourceName).Key("login_url").HasValue(fmt.Sprintf("https://test-%d.internal/login", data.RandomInteger)),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.relay_state").HasValue("/samlHome"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipal_samlSingleSignOnInvalidMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.samlSingleSignOn(data, "password"),
+			ExpectError: regexp.MustCompile("`saml_single_sign_on` can only be specified when `preferred_single_sign_on_mode` is \"saml\""),
+		},
+	})
+}
+
 func TestAccServicePrincipal_featureTags(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
 	r := ServicePrincipalResource{}
@@ -235,6 +313,14 @@ func TestAccServicePrincipal_owners(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.singleOwner(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
 		{
 			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
@@ -442,6 +528,26 @@ resource "azuread_service_principal" "test" {
 `, r.templateComplete(data), data.RandomInteger)
 }
 
+func (ServicePrincipalResource) samlSingleSignOn(data acceptance.TestData, mode string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id                = azuread_application.test.application_id
+  login_url                     = "https://test-%[1]d.internal/login"
+  preferred_single_sign_on_mode = "%[2]s"
+
+  saml_single_sign_on {
+    relay_state = "/samlHome"
+  }
+}
+`, data.RandomInteger, mode)
+}
+
 func (r ServicePrincipalResource) featureTags(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -535,6 +641,61 @@ resource "azuread_user" "testC" {
 `, data.RandomInteger, data.RandomPassword)
 }
 
+func (ServicePrincipalResource) appRoleAssignmentRequired(data acceptance.TestData, roleId string, required bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Users can access the application"
+    display_name         = "User"
+    enabled              = true
+    id                   = "%[3]s"
+    value                = "User.Access"
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  application_id               = azuread_application.test.application_id
+  app_role_assignment_required = %[4]t
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_app_role_assignment" "test" {
+  app_role_id         = azuread_service_principal.test.app_role_ids["User.Access"]
+  principal_object_id = azuread_user.test.object_id
+  resource_object_id  = azuread_service_principal.test.object_id
+}
+`, data.RandomInteger, data.RandomPassword, roleId, required)
+}
+
+func (ServicePrincipalResource) notificationEmailAddresses(data acceptance.TestData, address string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id               = azuread_application.test.application_id
+  notification_email_addresses = ["%[2]s"]
+}
+`, data.RandomInteger, address)
+}
+
 func (ServicePrincipalResource) noOwners(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
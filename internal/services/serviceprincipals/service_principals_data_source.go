@@ -17,6 +17,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
@@ -118,6 +119,12 @@ func servicePrincipalsDataSource() *schema.Resource {
 							Computed:    true,
 						},
 
+						"object_id": {
+							Description: "The object ID of the service principal",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"preferred_single_sign_on_mode": {
 							Description: "The single sign-on mode configured for this application. Azure AD uses the preferred single sign-on mode to launch the application from Microsoft 365 or the Azure AD My Apps",
 							Type:        schema.TypeString,
@@ -204,12 +211,12 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *schema.ResourceData
 
 			count := len(*result)
 			if count > 1 {
-				return tf.ErrorDiagPathF(nil, "mail_nicknames", "More than one service principal found with application ID: %q", v)
+				return tf.ErrorDiagPathF(nil, "application_ids", "More than one service principal found with application ID: %q", v)
 			} else if count == 0 {
 				if ignoreMissing {
 					continue
 				}
-				return tf.ErrorDiagPathF(err, "mail_nicknames", "Service principal not found with application ID: %q", v)
+				return tf.ErrorDiagPathF(err, "application_ids", "Service principal not found with application ID: %q", v)
 			}
 
 			servicePrincipals = append(servicePrincipals, (*result)[0])
@@ -218,11 +225,11 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *schema.ResourceData
 		expectedCount = len(displayNames)
 		for _, v := range displayNames {
 			query := odata.Query{
-				Filter: fmt.Sprintf("displayName eq '%s'", v),
+				Filter: fmt.Sprintf("displayName eq '%s'", utils.EscapeSingleQuote(v.(string))),
 			}
 			result, _, err := client.List(ctx, query)
 			if err != nil {
-				return tf.ErrorDiagF(err, "Finding service principal; with display name: %q", v)
+				return tf.ErrorDiagF(err, "Finding service principal with display name: %q", v)
 			}
 			if result == nil {
 				return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
@@ -248,12 +255,12 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *schema.ResourceData
 					if ignoreMissing {
 						continue
 					}
-					return tf.ErrorDiagPathF(nil, "object_id", "Service principal not found with object ID: %q", v)
+					return tf.ErrorDiagPathF(nil, "object_ids", "Service principal not found with object ID: %q", v)
 				}
 				return tf.ErrorDiagF(err, "Retrieving service principal with object ID: %q", v)
 			}
 			if u == nil {
-				return tf.ErrorDiagPathF(nil, "object_id", "Service principal not found with object ID: %q", v)
+				return tf.ErrorDiagPathF(nil, "object_ids", "Service principal not found with object ID: %q", v)
 			}
 
 			servicePrincipals = append(servicePrincipals, *u)
@@ -274,12 +281,16 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *schema.ResourceData
 			return tf.ErrorDiagF(errors.New("API returned service principal with nil object ID or displayName"), "Bad API Response")
 		}
 
-		objectIds = append(objectIds, *s.ID)
-		displayNames = append(displayNames, *s.DisplayName)
+		// Keep the lists parallel so that they can be indexed together
+		appId := ""
 		if s.AppId != nil {
-			applicationIds = append(applicationIds, *s.AppId)
+			appId = *s.AppId
 		}
 
+		applicationIds = append(applicationIds, appId)
+		displayNames = append(displayNames, *s.DisplayName)
+		objectIds = append(objectIds, *s.ID)
+
 		servicePrincipalNames := make([]string, 0)
 		if s.ServicePrincipalNames != nil {
 			for _, name := range *s.ServicePrincipalNames {
@@ -296,6 +307,7 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *schema.ResourceData
 		sp["app_role_assignment_required"] = s.AppRoleAssignmentRequired
 		sp["application_id"] = s.AppId
 		sp["application_tenant_id"] = s.AppOwnerOrganizationId
+		sp["object_id"] = s.ID
 		sp["preferred_single_sign_on_mode"] = s.PreferredSingleSignOnMode
 		sp["saml_metadata_url"] = s.SamlMetadataUrl
 		sp["service_principal_names"] = servicePrincipalNames
@@ -22,6 +22,8 @@ func TestAccServicePrincipalsDataSource_byApplicationIds(t *testing.T) {
 			check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
 			check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
 			check.That(data.ResourceName).Key("service_principals.#").HasValue("2"),
+			check.That(data.ResourceName).Key("service_principals.0.object_id").IsUuid(),
+			check.That(data.ResourceName).Key("service_principals.1.object_id").IsUuid(),
 		),
 	}})
 }
@@ -1,8 +1,11 @@
 package serviceprincipals
 
 import (
+	"context"
+
 	"github.com/manicminer/hamilton/msgraph"
 
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
@@ -33,3 +36,18 @@ func flattenSamlSingleSignOn(in *msgraph.SamlSingleSignOnSettings) []map[string]
 		"relay_state": relayState,
 	}}
 }
+
+// servicePrincipalWaitForOwners waits for the owners of a service principal to reflect the desired owners, since
+// ownership changes are not always immediately visible when reading the service principal back
+func servicePrincipalWaitForOwners(ctx context.Context, client *msgraph.ServicePrincipalsClient, id string, desiredOwners []string) error {
+	return helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		owners, _, err := client.ListOwners(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if owners == nil {
+			return utils.Bool(len(desiredOwners) == 0), nil
+		}
+		return utils.Bool(utils.EqualStringSets(*owners, desiredOwners)), nil
+	})
+}
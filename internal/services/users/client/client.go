@@ -7,19 +7,24 @@ import (
 )
 
 type Client struct {
-	DirectoryObjectsClient *msgraph.DirectoryObjectsClient
-	UsersClient            *msgraph.UsersClient
+	DirectoryObjectsClient        *msgraph.DirectoryObjectsClient
+	UserExtensionAttributesClient *UserExtensionAttributesClient
+	UsersClient                   *msgraph.UsersClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	directoryObjectsClient := msgraph.NewDirectoryObjectsClient(o.TenantID)
 	o.ConfigureClient(&directoryObjectsClient.BaseClient)
 
+	userExtensionAttributesClient := NewUserExtensionAttributesClient(o.TenantID)
+	o.ConfigureClient(&userExtensionAttributesClient.BaseClient)
+
 	usersClient := msgraph.NewUsersClient(o.TenantID)
 	o.ConfigureClient(&usersClient.BaseClient)
 
 	return &Client{
-		DirectoryObjectsClient: directoryObjectsClient,
-		UsersClient:            usersClient,
+		DirectoryObjectsClient:        directoryObjectsClient,
+		UserExtensionAttributesClient: userExtensionAttributesClient,
+		UsersClient:                   usersClient,
 	}
 }
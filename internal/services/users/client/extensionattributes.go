@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+// UserExtensionAttributesCount is the number of on-premises extension attributes available for a user, named
+// extensionAttribute1 through extensionAttribute15.
+const UserExtensionAttributesCount = 15
+
+// UserExtensionAttributes describes the on-premises extension attributes of a user, which are not yet modelled by the
+// Hamilton SDK. Attributes with a nil value are sent as null, so that they are cleared.
+type UserExtensionAttributes struct {
+	OnPremisesExtensionAttributes map[string]*string `json:"onPremisesExtensionAttributes"`
+}
+
+type UserExtensionAttributesClient struct {
+	BaseClient msgraph.Client
+}
+
+func NewUserExtensionAttributesClient(tenantId string) *UserExtensionAttributesClient {
+	return &UserExtensionAttributesClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Get retrieves the on-premises extension attributes for a user.
+func (c *UserExtensionAttributesClient) Get(ctx context.Context, id string) (*UserExtensionAttributes, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  odata.Query{Select: []string{"onPremisesExtensionAttributes"}},
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/users/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UserExtensionAttributesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var attributes UserExtensionAttributes
+	if err := json.Unmarshal(respBody, &attributes); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &attributes, status, nil
+}
+
+// Update amends the on-premises extension attributes for a user. This is only possible for users that are not
+// synchronized from an on-premises directory.
+func (c *UserExtensionAttributesClient) Update(ctx context.Context, id string, attributes UserExtensionAttributes) (int, error) {
+	var status int
+
+	body, err := json.Marshal(attributes)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/users/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("UserExtensionAttributesClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,151 @@
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func deletedUsersDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: deletedUsersDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name_prefix": {
+				Description:      "Only return deleted users whose display name starts with this prefix",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"object_ids": {
+				Description: "The object IDs of the deleted users",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"user_count": {
+				Description: "The number of deleted users found",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"users": {
+				Description: "A list of deleted users",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deleted_date_time": {
+							Description: "The date and time when the user was deleted, formatted as an RFC3339 date string",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"mail_nickname": {
+							Description: "The email alias of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The object ID of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"user_principal_name": {
+							Description: "The user principal name (UPN) of the user, which is prefixed with the object ID of the user whilst it is deleted",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func deletedUsersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Users.UsersClient
+	client.BaseClient.DisableRetries = true
+
+	query := odata.Query{
+		Select: []string{"deletedDateTime", "displayName", "id", "mailNickname", "userPrincipalName"},
+	}
+	if prefix := d.Get("display_name_prefix").(string); prefix != "" {
+		// Filtering deleted items requires an advanced query
+		query.Filter = fmt.Sprintf("startswith(displayName, '%s')", utils.EscapeSingleQuote(prefix))
+		query = helpers.AdvancedQuery(query)
+	}
+
+	result, _, err := client.ListDeleted(ctx, query)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve deleted users")
+	}
+	if result == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+	}
+
+	objectIds := make([]string, 0)
+	userList := make([]map[string]interface{}, 0)
+	for _, u := range *result {
+		if u.ID == nil {
+			return tf.ErrorDiagF(errors.New("API returned deleted user with nil object ID"), "Bad API Response")
+		}
+
+		deletedDateTime := ""
+		if u.DeletedDateTime != nil {
+			deletedDateTime = u.DeletedDateTime.Format(time.RFC3339)
+		}
+
+		objectIds = append(objectIds, *u.ID)
+		userList = append(userList, map[string]interface{}{
+			"deleted_date_time":   deletedDateTime,
+			"display_name":        u.DisplayName,
+			"mail_nickname":       u.MailNickname,
+			"object_id":           u.ID,
+			"user_principal_name": u.UserPrincipalName,
+		})
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(objectIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("deletedUsers#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "object_ids", objectIds)
+	tf.Set(d, "user_count", len(userList))
+	tf.Set(d, "users", userList)
+
+	return nil
+}
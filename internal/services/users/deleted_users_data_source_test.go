@@ -0,0 +1,43 @@
+package users_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DeletedUsersDataSource struct{}
+
+func TestAccDeletedUsersDataSource_byDisplayNamePrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_deleted_users", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: UserResource{}.basic(data),
+		},
+		{
+			Config: DeletedUsersDataSource{}.byDisplayNamePrefix(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("user_count").HasValue("1"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("users.#").HasValue("1"),
+				check.That(data.ResourceName).Key("users.0.display_name").HasValue(fmt.Sprintf("acctestUser-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("users.0.deleted_date_time").Exists(),
+			),
+		},
+	})
+}
+
+func (DeletedUsersDataSource) byDisplayNamePrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_deleted_users" "test" {
+  display_name_prefix = "acctestUser-%[1]d"
+}
+`, data.RandomInteger)
+}
@@ -21,8 +21,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_user":  userDataSource(),
-		"azuread_users": usersData(),
+		"azuread_deleted_users": deletedUsersDataSource(),
+		"azuread_user":          userDataSource(),
+		"azuread_users":         usersData(),
 	}
 }
 
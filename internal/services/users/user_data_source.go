@@ -210,6 +210,15 @@ func userDataSource() *schema.Resource {
 				Computed:    true,
 			},
 
+			"onpremises_extension_attributes": {
+				Description: "A map of on-premises extension attributes for the user",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"onpremises_immutable_id": {
 				Description: "The value used to associate an on-premise Active Directory user account with their Azure AD user object",
 				Type:        schema.TypeString,
@@ -309,8 +318,55 @@ func userDataSource() *schema.Resource {
 	}
 }
 
+// userDataSourceSelect is the list of user properties requested from the API, which should be kept in sync with the
+// properties read in userDataSourceRead
+var userDataSourceSelect = []string{
+	"accountEnabled",
+	"ageGroup",
+	"businessPhones",
+	"city",
+	"companyName",
+	"consentProvidedForMinor",
+	"country",
+	"creationType",
+	"department",
+	"displayName",
+	"employeeId",
+	"employeeOrgData",
+	"employeeType",
+	"externalUserState",
+	"faxNumber",
+	"givenName",
+	"id",
+	"imAddresses",
+	"jobTitle",
+	"mail",
+	"mailNickname",
+	"mobilePhone",
+	"officeLocation",
+	"onPremisesDistinguishedName",
+	"onPremisesDomainName",
+	"onPremisesImmutableId",
+	"onPremisesSamAccountName",
+	"onPremisesSecurityIdentifier",
+	"onPremisesSyncEnabled",
+	"onPremisesUserPrincipalName",
+	"otherMails",
+	"postalCode",
+	"preferredLanguage",
+	"proxyAddresses",
+	"showInAddressList",
+	"state",
+	"streetAddress",
+	"surname",
+	"usageLocation",
+	"userPrincipalName",
+	"userType",
+}
+
 func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.UsersClient
+	extensionAttributesClient := meta.(*clients.Client).Users.UserExtensionAttributesClient
 	client.BaseClient.DisableRetries = true
 
 	var user msgraph.User
@@ -318,6 +374,7 @@ func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if upn, ok := d.Get("user_principal_name").(string); ok && upn != "" {
 		query := odata.Query{
 			Filter: fmt.Sprintf("userPrincipalName eq '%s'", utils.EscapeSingleQuote(upn)),
+			Select: userDataSourceSelect,
 		}
 		users, _, err := client.List(ctx, query)
 		if err != nil {
@@ -334,7 +391,7 @@ func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 		user = (*users)[0]
 	} else if objectId, ok := d.Get("object_id").(string); ok && objectId != "" {
-		u, status, err := client.Get(ctx, objectId, odata.Query{})
+		u, status, err := client.Get(ctx, objectId, odata.Query{Select: userDataSourceSelect})
 		if err != nil {
 			if status == http.StatusNotFound {
 				return tf.ErrorDiagPathF(nil, "object_id", "User not found with object ID: %q", objectId)
@@ -348,6 +405,7 @@ func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interf
 	} else if mailNickname, ok := d.Get("mail_nickname").(string); ok && mailNickname != "" {
 		query := odata.Query{
 			Filter: fmt.Sprintf("mailNickname eq '%s'", utils.EscapeSingleQuote(mailNickname)),
+			Select: userDataSourceSelect,
 		}
 		users, _, err := client.List(ctx, query)
 		if err != nil {
@@ -373,6 +431,11 @@ func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(*user.ID)
 
+	extensionAttributes, _, err := extensionAttributesClient.Get(ctx, *user.ID)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving extension attributes for user with object ID: %q", *user.ID)
+	}
+
 	tf.Set(d, "account_enabled", user.AccountEnabled)
 	tf.Set(d, "age_group", user.AgeGroup)
 	tf.Set(d, "business_phones", user.BusinessPhones)
@@ -397,6 +460,7 @@ func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interf
 	tf.Set(d, "office_location", user.OfficeLocation)
 	tf.Set(d, "onpremises_distinguished_name", user.OnPremisesDistinguishedName)
 	tf.Set(d, "onpremises_domain_name", user.OnPremisesDomainName)
+	tf.Set(d, "onpremises_extension_attributes", flattenUserExtensionAttributes(extensionAttributes))
 	tf.Set(d, "onpremises_immutable_id", user.OnPremisesImmutableId)
 	tf.Set(d, "onpremises_sam_account_name", user.OnPremisesSamAccountName)
 	tf.Set(d, "onpremises_security_identifier", user.OnPremisesSecurityIdentifier)
@@ -414,10 +478,18 @@ func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interf
 	tf.Set(d, "user_principal_name", user.UserPrincipalName)
 	tf.Set(d, "user_type", user.UserType)
 
+	// The API returns a null employeeOrgData when both the cost center and division have been cleared
+	costCenter, division := "", ""
 	if user.EmployeeOrgData != nil {
-		tf.Set(d, "cost_center", user.EmployeeOrgData.CostCenter)
-		tf.Set(d, "division", user.EmployeeOrgData.Division)
+		if user.EmployeeOrgData.CostCenter != nil {
+			costCenter = *user.EmployeeOrgData.CostCenter
+		}
+		if user.EmployeeOrgData.Division != nil {
+			division = *user.EmployeeOrgData.Division
+		}
 	}
+	tf.Set(d, "cost_center", costCenter)
+	tf.Set(d, "division", division)
 
 	managerId := ""
 	manager, status, err := client.GetManager(ctx, *user.ID)
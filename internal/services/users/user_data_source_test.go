@@ -2,6 +2,7 @@ package users_test
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -51,6 +52,19 @@ func TestAccUserDataSource_byObjectIdNonexistent(t *testing.T) {
 	}})
 }
 
+func TestAccUserDataSource_noManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user", "test")
+	r := UserDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: r.noManager(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("object_id").IsUuid(),
+			check.That(data.ResourceName).Key("manager_id").HasValue(""),
+		),
+	}})
+}
+
 func TestAccUserDataSource_byMailNickname(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_user", "test")
 	r := UserDataSource{}
@@ -70,6 +84,37 @@ func TestAccUserDataSource_byMailNicknameNonexistent(t *testing.T) {
 	}})
 }
 
+func TestAccUserDataSource_extensionAttributes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: UserDataSource{}.byObjectIdWithExtensionAttributes(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("onpremises_extension_attributes.%").HasValue("1"),
+			check.That(data.ResourceName).Key("onpremises_extension_attributes.extensionAttribute1").HasValue(fmt.Sprintf("acctestUser-%d-Attr1", data.RandomInteger)),
+		),
+	}})
+}
+
+// Extension attributes for synchronized users are managed on-premises, so this test requires an existing user that is
+// synchronized from an on-premises directory and has at least one extension attribute populated
+func TestAccUserDataSource_extensionAttributesSynced(t *testing.T) {
+	objectId := os.Getenv("ARM_TEST_SYNCED_USER_OBJECT_ID")
+	if objectId == "" {
+		t.Skip("ARM_TEST_SYNCED_USER_OBJECT_ID must be set for synchronized user tests")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_user", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: UserDataSource{}.byObjectIdExisting(objectId),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("onpremises_sync_enabled").HasValue("true"),
+			check.That(data.ResourceName).Key("onpremises_extension_attributes.%").MatchesRegex(regexp.MustCompile("^([1-9]|1[0-5])$")),
+		),
+	}})
+}
+
 func (UserDataSource) testCheckFunc(data acceptance.TestData) resource.TestCheckFunc {
 	return resource.ComposeTestCheckFunc(
 		check.That(data.ResourceName).Key("account_enabled").Exists(),
@@ -129,6 +174,48 @@ data "azuread_user" "test" {
 `, UserResource{}.complete(data))
 }
 
+func (UserDataSource) byObjectIdWithExtensionAttributes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+
+  onpremises_extension_attributes = {
+    extensionAttribute1 = "acctestUser-%[1]d-Attr1"
+  }
+}
+
+data "azuread_user" "test" {
+  object_id = azuread_user.test.object_id
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserDataSource) byObjectIdExisting(objectId string) string {
+	return fmt.Sprintf(`
+data "azuread_user" "test" {
+  object_id = %[1]q
+}
+`, objectId)
+}
+
+func (UserDataSource) noManager(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user" "test" {
+  object_id = azuread_user.test.object_id
+}
+`, UserResource{}.basic(data))
+}
+
 func (UserDataSource) byObjectIdNonexistent() string {
 	return `
 data "azuread_user" "test" {
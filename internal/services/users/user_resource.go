@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
@@ -39,12 +41,12 @@ func userResource() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+		Importer: tf.ValidateResourceIDPriorToImportThen(func(id string) error {
 			if _, err := uuid.ParseUUID(id); err != nil {
 				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
 			}
 			return nil
-		}),
+		}, userResourceImport),
 
 		Schema: map[string]*schema.Schema{
 			"user_principal_name": {
@@ -69,6 +71,13 @@ func userResource() *schema.Resource {
 				Default:     true,
 			},
 
+			"allow_disabling_current_principal": {
+				Description: "Whether to allow disabling the account of the user that Terraform is currently authenticated as",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"age_group": {
 				Description: "The age group of the user",
 				Type:        schema.TypeString,
@@ -87,7 +96,8 @@ func userResource() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					DiffSuppressFunc: suppress.PhoneNumber,
 				},
 			},
 
@@ -167,9 +177,10 @@ func userResource() *schema.Resource {
 			},
 
 			"fax_number": {
-				Description: "The fax number of the user",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The fax number of the user",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.PhoneNumber,
 			},
 
 			"job_title": {
@@ -179,10 +190,12 @@ func userResource() *schema.Resource {
 			},
 
 			"mail": {
-				Description: "The SMTP address for the user. Cannot be unset.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:      "The SMTP address for the user. Cannot be unset.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateDiagFunc: validate.StringIsEmailAddress,
 			},
 
 			"mail_nickname": {
@@ -199,9 +212,10 @@ func userResource() *schema.Resource {
 			},
 
 			"mobile_phone": {
-				Description: "The primary cellular telephone number for the user",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The primary cellular telephone number for the user",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.PhoneNumber,
 			},
 
 			"office_location": {
@@ -210,6 +224,17 @@ func userResource() *schema.Resource {
 				Optional:    true,
 			},
 
+			"onpremises_extension_attributes": {
+				Description:      "A map of on-premises extension attributes for the user, with keys `extensionAttribute1` through `extensionAttribute15`. These cannot be set for users synchronized from an on-premises directory",
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: userValidateExtensionAttributes,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"onpremises_immutable_id": {
 				Description: "The value used to associate an on-premise Active Directory user account with their Azure AD user object. This must be specified if you are using a federated domain for the user's `user_principal_name` property when creating a new user account",
 				Type:        schema.TypeString,
@@ -254,6 +279,13 @@ func userResource() *schema.Resource {
 				Optional:    true,
 			},
 
+			"permanently_delete": {
+				Description: "If `true`, the user will be permanently deleted from the deleted items when it is destroyed, instead of being soft-deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"preferred_language": {
 				Description:      "The user's preferred language, in ISO 639-1 notation",
 				Type:             schema.TypeString,
@@ -261,6 +293,20 @@ func userResource() *schema.Resource {
 				ValidateDiagFunc: validate.ISO639Language,
 			},
 
+			"restore_if_deleted": {
+				Description: "If `true`, a soft-deleted user having the same user principal name will be restored instead of creating a new user",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"skip_password_complexity_check": {
+				Description: "If `true`, the password will not be checked against the default Azure AD password complexity requirements before it is sent to the API. This can be useful for tenants having a custom password policy",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"show_in_address_list": {
 				Description: "Whether or not the Outlook global address list should include this user",
 				Type:        schema.TypeBool,
@@ -387,24 +433,62 @@ func userResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m
 		return fmt.Errorf("`consent_provided_for_minor` can only be set to %q or %q when `age_group` is %q or %q",
 			msgraph.ConsentProvidedForMinorGranted, msgraph.ConsentProvidedForMinorDenied, msgraph.AgeGroupAdult, msgraph.AgeGroupNotAdult)
 	}
+
+	// Check the password complexity up front, since the API gives little detail when rejecting a weak password
+	if password := diff.Get("password").(string); diff.HasChange("password") && tf.ValueIsNotEmptyOrUnknown(password) &&
+		!diff.Get("disable_strong_password").(bool) && !diff.Get("skip_password_complexity_check").(bool) {
+		if diags := validate.Password(password, cty.GetAttrPath("password")); diags.HasError() {
+			return fmt.Errorf("`password` does not satisfy the default password policy: %s. If your tenant has a custom password policy, set `skip_password_complexity_check` to `true`", diags[0].Summary)
+		}
+	}
+
+	// The mail address is managed by Exchange Online for users with a mailbox, in which case a new value may be ignored
+	if _, newMail := diff.GetChange("mail"); diff.HasChange("mail") && tf.ValueIsNotEmptyOrUnknown(newMail) {
+		log.Printf("[WARN] The mail address for user %q may be ignored if the user has an Exchange Online mailbox", diff.Get("user_principal_name").(string))
+	}
+
 	return nil
 }
 
 func userResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.UsersClient
+	extensionAttributesClient := meta.(*clients.Client).Users.UserExtensionAttributesClient
 	directoryObjectsClient := meta.(*clients.Client).Users.DirectoryObjectsClient
 
+	upn := d.Get("user_principal_name").(string)
+
+	if d.Get("restore_if_deleted").(bool) {
+		deletedUser, err := userFindDeleted(ctx, client, upn)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not check for deleted user(s)")
+		}
+		if deletedUser != nil {
+			if _, _, err := client.RestoreDeleted(ctx, *deletedUser.ID); err != nil {
+				return tf.ErrorDiagF(err, "Could not restore deleted user with object ID %q", *deletedUser.ID)
+			}
+
+			d.SetId(*deletedUser.ID)
+
+			// Retain the existing mail nickname unless one is configured
+			if d.Get("mail_nickname").(string) == "" {
+				tf.Set(d, "mail_nickname", deletedUser.MailNickname)
+			}
+
+			// The user was restored with its previous properties, so we'll update it just as if it was imported
+			return userResourceUpdate(ctx, d, meta)
+		}
+	}
+
 	password := d.Get("password").(string)
 	if password == "" {
 		return tf.ErrorDiagPathF(errors.New("`password` is required when creating a new user"), "password", "Could not create user")
 	}
 
-	upn := d.Get("user_principal_name").(string)
 	mailNickName := d.Get("mail_nickname").(string)
 
 	// Default mail nickname to the first part of the UPN (matches the portal)
 	if mailNickName == "" {
-		mailNickName = strings.Split(upn, "@")[0]
+		mailNickName = helpers.DeriveMailNickname(upn)
 	}
 
 	var passwordPolicies string
@@ -466,9 +550,36 @@ func userResourceCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		properties.OnPremisesImmutableId = utils.String(v.(string))
 	}
 
-	user, _, err := client.Create(ctx, properties)
+	// A 503 response does not guarantee that the user was not created, so before reattempting the creation, check
+	// whether a user already exists with the same user principal name
+	var user *msgraph.User
+	var lastStatus int
+	err := helpers.RetryOnThrottling(ctx, func(ctx context.Context) (status int, err error) {
+		if lastStatus == http.StatusServiceUnavailable {
+			existing, err := userFindByPrincipalName(ctx, client, upn)
+			if err != nil {
+				return 0, err
+			}
+			if existing != nil {
+				user = existing
+				return http.StatusOK, nil
+			}
+		}
+		user, status, err = client.Create(ctx, properties)
+		lastStatus = status
+		return
+	})
 	if err != nil {
-		return tf.ErrorDiagF(err, "Creating user %q", upn)
+		if helpers.IsMailNicknameConflict(err) {
+			return helpers.MailNicknameConflictDiag(err, "azuread_user", mailNickName)
+		}
+		if helpers.IsMailConflict(err) {
+			return helpers.MailConflictDiag(err, "azuread_user", d.Get("mail").(string))
+		}
+		if helpers.IsMailExchangeManaged(err) {
+			return helpers.MailExchangeManagedDiag(err, "azuread_user", d.Get("mail").(string))
+		}
+		return tf.ODataErrorDiagF(err, "Creating user %q", upn)
 	}
 
 	if user.ID == nil || *user.ID == "" {
@@ -487,18 +598,48 @@ func userResourceCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		return tf.ErrorDiagF(err, "Timed out whilst waiting for new user to be replicated in Azure AD")
 	}
 
+	// Users are enabled by default, so ensure that a disabled user is not observed as enabled after creation
+	if !d.Get("account_enabled").(bool) {
+		if err := userEnforceAccountEnabled(ctx, client, d.Id(), false); err != nil {
+			return tf.ErrorDiagPathF(err, "account_enabled", "Waiting for user with object ID %q to be disabled", d.Id())
+		}
+	}
+
+	if v, ok := d.GetOk("onpremises_extension_attributes"); ok && len(v.(map[string]interface{})) > 0 {
+		if _, err := extensionAttributesClient.Update(ctx, d.Id(), expandUserExtensionAttributes(v.(map[string]interface{}))); err != nil {
+			return tf.ErrorDiagPathF(err, "onpremises_extension_attributes", "Could not set extension attributes for user with object ID %q", d.Id())
+		}
+	}
+
 	if managerId := d.Get("manager_id").(string); managerId != "" {
 		if err := assignManager(ctx, client, directoryObjectsClient, d.Id(), managerId); err != nil {
 			return tf.ErrorDiagPathF(err, "manager_id", "Could not assign manager for user with object ID %q", d.Id())
 		}
 	}
 
-	return userResourceRead(ctx, d, meta)
+	configuredMail := d.Get("mail").(string)
+	diags := userResourceRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, userMailIgnoredWarning(configuredMail, d.Get("mail").(string))...)
 }
 
 func userResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.UsersClient
+	extensionAttributesClient := meta.(*clients.Client).Users.UserExtensionAttributesClient
 	directoryObjectsClient := meta.(*clients.Client).Users.DirectoryObjectsClient
+	if err := meta.(*clients.Client).LoadClaims(); err != nil {
+		return tf.ErrorDiagF(err, "Could not obtain claims for the authenticated principal")
+	}
+	callerId := meta.(*clients.Client).Claims.ObjectId
+
+	// Disabling the account that Terraform is authenticated as will cause all subsequent operations to fail
+	if d.HasChange("account_enabled") && !d.Get("account_enabled").(bool) && strings.EqualFold(d.Id(), callerId) && !d.Get("allow_disabling_current_principal").(bool) {
+		d.Partial(true)
+		return tf.ErrorDiagPathF(nil, "account_enabled", "Refusing to disable the user account that Terraform is currently authenticated as (object ID: %q). To proceed anyway, set `allow_disabling_current_principal = true`", callerId)
+	}
 
 	var passwordPolicies string
 	disableStrongPassword := d.Get("disable_strong_password").(bool)
@@ -568,8 +709,26 @@ func userResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		properties.OnPremisesImmutableId = utils.String(d.Get("onpremises_immutable_id").(string))
 	}
 
-	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Could not update user with ID: %q", d.Id())
+	err := helpers.RetryOnThrottling(ctx, func(ctx context.Context) (int, error) {
+		return client.Update(ctx, properties)
+	})
+	if err != nil {
+		if helpers.IsMailNicknameConflict(err) {
+			return helpers.MailNicknameConflictDiag(err, "azuread_user", d.Get("mail_nickname").(string))
+		}
+		if helpers.IsMailConflict(err) {
+			return helpers.MailConflictDiag(err, "azuread_user", d.Get("mail").(string))
+		}
+		if helpers.IsMailExchangeManaged(err) {
+			return helpers.MailExchangeManagedDiag(err, "azuread_user", d.Get("mail").(string))
+		}
+		return tf.ODataErrorDiagF(err, "Could not update user with ID: %q", d.Id())
+	}
+
+	if d.HasChange("onpremises_extension_attributes") {
+		if _, err := extensionAttributesClient.Update(ctx, d.Id(), expandUserExtensionAttributes(d.Get("onpremises_extension_attributes").(map[string]interface{}))); err != nil {
+			return tf.ErrorDiagPathF(err, "onpremises_extension_attributes", "Could not update extension attributes for user with object ID %q", d.Id())
+		}
 	}
 
 	if d.HasChange("manager_id") {
@@ -578,11 +737,21 @@ func userResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	return userResourceRead(ctx, d, meta)
+	configuredMail := ""
+	if d.HasChange("mail") {
+		configuredMail = d.Get("mail").(string)
+	}
+	diags := userResourceRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, userMailIgnoredWarning(configuredMail, d.Get("mail").(string))...)
 }
 
 func userResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.UsersClient
+	extensionAttributesClient := meta.(*clients.Client).Users.UserExtensionAttributesClient
 
 	objectId := d.Id()
 
@@ -596,6 +765,11 @@ func userResourceRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return tf.ErrorDiagF(err, "Retrieving user with object ID: %q", objectId)
 	}
 
+	extensionAttributes, _, err := extensionAttributesClient.Get(ctx, objectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving extension attributes for user with object ID: %q", objectId)
+	}
+
 	tf.Set(d, "about_me", user.AboutMe)
 	tf.Set(d, "account_enabled", user.AccountEnabled)
 	tf.Set(d, "age_group", user.AgeGroup)
@@ -621,6 +795,7 @@ func userResourceRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	tf.Set(d, "office_location", user.OfficeLocation)
 	tf.Set(d, "onpremises_distinguished_name", user.OnPremisesDistinguishedName)
 	tf.Set(d, "onpremises_domain_name", user.OnPremisesDomainName)
+	tf.Set(d, "onpremises_extension_attributes", flattenUserExtensionAttributes(extensionAttributes))
 	tf.Set(d, "onpremises_immutable_id", user.OnPremisesImmutableId)
 	tf.Set(d, "onpremises_sam_account_name", user.OnPremisesSamAccountName)
 	tf.Set(d, "onpremises_security_identifier", user.OnPremisesSecurityIdentifier)
@@ -655,10 +830,18 @@ func userResourceRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	tf.Set(d, "disable_strong_password", disableStrongPassword)
 	tf.Set(d, "disable_password_expiration", disablePasswordExpiration)
 
+	// The API returns a null employeeOrgData when both the cost center and division have been cleared
+	costCenter, division := "", ""
 	if user.EmployeeOrgData != nil {
-		tf.Set(d, "cost_center", user.EmployeeOrgData.CostCenter)
-		tf.Set(d, "division", user.EmployeeOrgData.Division)
+		if user.EmployeeOrgData.CostCenter != nil {
+			costCenter = *user.EmployeeOrgData.CostCenter
+		}
+		if user.EmployeeOrgData.Division != nil {
+			division = *user.EmployeeOrgData.Division
+		}
 	}
+	tf.Set(d, "cost_center", costCenter)
+	tf.Set(d, "division", division)
 
 	managerId := ""
 	manager, status, err := client.GetManager(ctx, objectId)
@@ -688,9 +871,12 @@ func userResourceDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		return tf.ErrorDiagPathF(err, "id", "Retrieving user with object ID %q", userId)
 	}
 
-	status, err = client.Delete(ctx, userId)
+	// Deleting many users at once can exhaust the SDK retry limit when throttled, so retry for the full timeout
+	err = helpers.RetryOnThrottling(ctx, func(ctx context.Context) (int, error) {
+		return client.Delete(ctx, userId)
+	})
 	if err != nil {
-		return tf.ErrorDiagPathF(err, "id", "Deleting user with object ID %q, got status %d", userId, status)
+		return tf.ODataErrorDiagPathF(err, "id", "Deleting user with object ID %q", userId)
 	}
 
 	// Wait for user object to be deleted
@@ -707,5 +893,48 @@ func userResourceDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		return tf.ErrorDiagF(err, "Waiting for deletion of user with object ID %q", userId)
 	}
 
+	// The user is now soft-deleted; optionally purge it from deleted items so that its user principal name and other
+	// unique properties are released immediately
+	if d.Get("permanently_delete").(bool) {
+		err = helpers.RetryOnThrottling(ctx, func(ctx context.Context) (int, error) {
+			return client.DeletePermanently(ctx, userId)
+		})
+		if err != nil {
+			return tf.ODataErrorDiagPathF(err, "id", "Permanently deleting user with object ID %q", userId)
+		}
+
+		if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+			client.BaseClient.DisableRetries = true
+			if _, status, err := client.GetDeleted(ctx, userId, odata.Query{}); err != nil {
+				if status == http.StatusNotFound {
+					return utils.Bool(false), nil
+				}
+				return nil, err
+			}
+			return utils.Bool(true), nil
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for permanent deletion of user with object ID %q", userId)
+		}
+	}
+
 	return nil
 }
+
+func userResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// allow_disabling_current_principal is not returned by the API, so set it to its default value to ensure that the
+	// first plan following an import does not show a diff for it
+	if err := d.Set("allow_disabling_current_principal", false); err != nil {
+		return nil, fmt.Errorf("setting `allow_disabling_current_principal` for imported user: %+v", err)
+	}
+	if err := d.Set("permanently_delete", false); err != nil {
+		return nil, fmt.Errorf("setting `permanently_delete` for imported user: %+v", err)
+	}
+	if err := d.Set("restore_if_deleted", false); err != nil {
+		return nil, fmt.Errorf("setting `restore_if_deleted` for imported user: %+v", err)
+	}
+	if err := d.Set("skip_password_complexity_check", false); err != nil {
+		return nil, fmt.Errorf("setting `skip_password_complexity_check` for imported user: %+v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -126,6 +126,282 @@ func TestAccUser_passwordOmitted(t *testing.T) {
 	})
 }
 
+func TestAccUser_passwordTooShort(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.password(data, "Ab1!"),
+			ExpectError: regexp.MustCompile("Password must be between 8 and 256 characters long"),
+		},
+	})
+}
+
+func TestAccUser_passwordValid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.password(data, data.RandomPassword),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccUser_mail(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.mail(data, fmt.Sprintf("acctestUser.%d@hashicorp.biz", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("mail").HasValue(fmt.Sprintf("acctestUser.%d@hashicorp.biz", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			// A difference in case only should not produce a diff
+			Config:   r.mail(data, fmt.Sprintf("ACCTESTUSER.%d@HASHICORP.BIZ", data.RandomInteger)),
+			PlanOnly: true,
+		},
+		{
+			// Removing mail from configuration should not produce a diff, since it cannot be unset
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccUser_mailOnCreate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			// A cloud-only user without a mailbox can have its mail address set directly
+			Config: r.mail(data, fmt.Sprintf("acctestUser.%d@hashicorp.biz", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("mail").HasValue(fmt.Sprintf("acctestUser.%d@hashicorp.biz", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.mail(data, fmt.Sprintf("acctestUser.%d@hashicorp.net", data.RandomInteger)),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("mail").HasValue(fmt.Sprintf("acctestUser.%d@hashicorp.net", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
+func TestAccUser_restoreIfDeleted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+	var userId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.restoreIfDeleted(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.objectId(data, &userId, false),
+			),
+		},
+		{
+			Config: `provider "azuread" {}`,
+		},
+		{
+			Config: r.restoreIfDeleted(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.objectId(data, &userId, true),
+			),
+		},
+		data.ImportStep("force_password_change", "password", "restore_if_deleted"),
+	})
+}
+
+func TestAccUser_softDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+	var userId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.permanentlyDelete(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.objectId(data, &userId, false),
+			),
+		},
+		{
+			Config: `provider "azuread" {}`,
+			Check: resource.ComposeTestCheckFunc(
+				r.isDeleted(&userId, true),
+			),
+		},
+	})
+}
+
+func TestAccUser_permanentlyDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+	var userId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.permanentlyDelete(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permanently_delete").HasValue("true"),
+				r.objectId(data, &userId, false),
+			),
+		},
+		data.ImportStep("force_password_change", "password", "permanently_delete"),
+		{
+			Config: `provider "azuread" {}`,
+			Check: resource.ComposeTestCheckFunc(
+				r.isDeleted(&userId, false),
+			),
+		},
+		{
+			// Recreating immediately with the same user principal name would conflict with a soft-deleted user
+			Config: r.permanentlyDelete(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccUser_accountDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.accountEnabled(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("account_enabled").HasValue("false"),
+				r.isAccountEnabled(data, false),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.accountEnabled(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("account_enabled").HasValue("true"),
+				r.isAccountEnabled(data, true),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
+func TestAccUser_employeeOrgData(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.employeeOrgData(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cost_center").HasValue(fmt.Sprintf("acctestUser-%d-CostCenter", data.RandomInteger)),
+				check.That(data.ResourceName).Key("division").HasValue(fmt.Sprintf("acctestUser-%d-Division", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cost_center").IsEmpty(),
+				check.That(data.ResourceName).Key("division").IsEmpty(),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
+func TestAccUser_extensionAttributes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.extensionAttributes(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("onpremises_extension_attributes.%").HasValue("2"),
+				check.That(data.ResourceName).Key("onpremises_extension_attributes.extensionAttribute1").HasValue(fmt.Sprintf("acctestUser-%d-Attr1", data.RandomInteger)),
+				check.That(data.ResourceName).Key("onpremises_extension_attributes.extensionAttribute15").HasValue(fmt.Sprintf("acctestUser-%d-Attr15", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.extensionAttributesUpdated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("onpremises_extension_attributes.%").HasValue("1"),
+				check.That(data.ResourceName).Key("onpremises_extension_attributes.extensionAttribute2").HasValue(fmt.Sprintf("acctestUser-%d-Attr2", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
+func TestAccUser_extensionAttributesInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.extensionAttributesInvalid(data),
+			ExpectError: regexp.MustCompile("Invalid extension attribute"),
+		},
+	})
+}
+
+func TestAccUser_phoneNumberFormatting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.phoneNumbers(data, "+1 425 555 0100", "+1 (425) 555-0101"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			// Equivalent numbers with different formatting should not produce a diff
+			Config:   r.phoneNumbers(data, "+14255550100", "+14255550101"),
+			PlanOnly: true,
+		},
+	})
+}
+
 func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Users.UsersClient
 	client.BaseClient.DisableRetries = true
@@ -140,6 +416,76 @@ func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state
 	return utils.Bool(user.ID != nil && *user.ID == state.ID), nil
 }
 
+// objectId records the object ID of the user in the state, or when compare is true, checks that it is unchanged
+func (UserResource) objectId(data acceptance.TestData, objectId *string, compare bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+		if compare && user.Primary.ID != *objectId {
+			return fmt.Errorf("expected user to be restored with object ID %q, got %q", *objectId, user.Primary.ID)
+		}
+		*objectId = user.Primary.ID
+		return nil
+	}
+}
+
+// isDeleted checks that the user has been removed, and whether it remains in the deleted items
+func (UserResource) isDeleted(userId *string, softDeleted bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Users.UsersClient
+		client.BaseClient.DisableRetries = true
+		ctx := clients.StopContext
+
+		if _, status, err := client.Get(ctx, *userId, odata.Query{}); err == nil {
+			return fmt.Errorf("user with object ID %q still exists", *userId)
+		} else if status != http.StatusNotFound {
+			return fmt.Errorf("failed to retrieve user with object ID %q: %+v", *userId, err)
+		}
+
+		_, status, err := client.GetDeleted(ctx, *userId, odata.Query{})
+		if softDeleted && err != nil {
+			return fmt.Errorf("expected user with object ID %q to be soft-deleted, got status %d: %+v", *userId, status, err)
+		}
+		if !softDeleted {
+			if err == nil {
+				return fmt.Errorf("expected user with object ID %q to be permanently deleted, but it was found in deleted items", *userId)
+			} else if status != http.StatusNotFound {
+				return fmt.Errorf("failed to retrieve deleted user with object ID %q: %+v", *userId, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// isAccountEnabled checks that the user is enabled or disabled as expected, without waiting for replication
+func (UserResource) isAccountEnabled(data acceptance.TestData, accountEnabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := acceptance.AzureADProvider.Meta().(*clients.Client)
+		client := clients.Users.UsersClient
+		client.BaseClient.DisableRetries = true
+		ctx := clients.StopContext
+
+		rs, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		user, _, err := client.Get(ctx, rs.Primary.ID, odata.Query{Select: []string{"accountEnabled"}})
+		if err != nil {
+			return fmt.Errorf("failed to retrieve user with object ID %q: %+v", rs.Primary.ID, err)
+		}
+		if user.AccountEnabled == nil || *user.AccountEnabled != accountEnabled {
+			return fmt.Errorf("expected accountEnabled to be %t for user with object ID %q", accountEnabled, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func (UserResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -214,6 +560,103 @@ resource "azuread_user" "test" {
 `, data.RandomInteger, data.RandomPassword, data.RandomString)
 }
 
+func (UserResource) employeeOrgData(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  cost_center         = "acctestUser-%[1]d-CostCenter"
+  division            = "acctestUser-%[1]d-Division"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) extensionAttributes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+
+  onpremises_extension_attributes = {
+    extensionAttribute1  = "acctestUser-%[1]d-Attr1"
+    extensionAttribute15 = "acctestUser-%[1]d-Attr15"
+  }
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) extensionAttributesUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+
+  onpremises_extension_attributes = {
+    extensionAttribute2 = "acctestUser-%[1]d-Attr2"
+  }
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) extensionAttributesInvalid(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+
+  onpremises_extension_attributes = {
+    extensionAttribute16 = "acctestUser-%[1]d-Attr16"
+  }
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) phoneNumbers(data acceptance.TestData, mobilePhone, businessPhone string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  mobile_phone        = "%[3]s"
+  business_phones     = ["%[4]s"]
+}
+`, data.RandomInteger, data.RandomPassword, mobilePhone, businessPhone)
+}
+
 func (UserResource) threeUsersABC(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -278,3 +721,87 @@ resource "azuread_user" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (UserResource) password(data acceptance.TestData, password string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, password)
+}
+
+func (UserResource) mail(data acceptance.TestData, mail string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  mail                = "%[3]s"
+}
+`, data.RandomInteger, data.RandomPassword, mail)
+}
+
+func (UserResource) restoreIfDeleted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  restore_if_deleted  = true
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) permanentlyDelete(data acceptance.TestData, permanentlyDelete bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  permanently_delete  = %[3]t
+}
+`, data.RandomInteger, data.RandomPassword, permanentlyDelete)
+}
+
+func (UserResource) accountEnabled(data acceptance.TestData, accountEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  account_enabled     = %[3]t
+}
+`, data.RandomInteger, data.RandomPassword, accountEnabled)
+}
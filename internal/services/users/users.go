@@ -4,10 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/manicminer/hamilton/msgraph"
 	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	usersClient "github.com/hashicorp/terraform-provider-azuread/internal/services/users/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
 func assignManager(ctx context.Context, client *msgraph.UsersClient, directoryObjectsClient *msgraph.DirectoryObjectsClient, userId, managerId string) error {
@@ -41,3 +47,161 @@ func assignManager(ctx context.Context, client *msgraph.UsersClient, directoryOb
 
 	return nil
 }
+
+// userFindByPrincipalName returns the user having the specified user principal name, or nil if there is none.
+func userFindByPrincipalName(ctx context.Context, client *msgraph.UsersClient, upn string) (*msgraph.User, error) {
+	users, _, err := client.List(ctx, odata.Query{
+		Filter: fmt.Sprintf("userPrincipalName eq '%s'", utils.EscapeSingleQuote(upn)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list Users with user principal name %q: %+v", upn, err)
+	}
+
+	if users != nil {
+		for _, user := range *users {
+			if user.UserPrincipalName != nil && strings.EqualFold(*user.UserPrincipalName, upn) {
+				return &user, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// userFindDeleted returns the soft-deleted user having the specified user principal name, or nil if there is none.
+// Deleted users have their object ID (without hyphens) prepended to their user principal name, so both forms are matched.
+func userFindDeleted(ctx context.Context, client *msgraph.UsersClient, upn string) (*msgraph.User, error) {
+	users, _, err := client.ListDeleted(ctx, odata.Query{
+		Select: []string{"id", "mailNickname", "userPrincipalName"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list deleted Users: %+v", err)
+	}
+
+	if users != nil {
+		for _, user := range *users {
+			if user.ID == nil || user.UserPrincipalName == nil {
+				continue
+			}
+			prefix := strings.ReplaceAll(*user.ID, "-", "")
+			if strings.EqualFold(*user.UserPrincipalName, upn) || strings.EqualFold(*user.UserPrincipalName, prefix+upn) {
+				return &user, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// userEnforceAccountEnabled waits for the accountEnabled property of a user to reflect the desired value. New users can
+// briefly appear enabled regardless of the value they were created with, so the desired value is reapplied whenever a
+// different value is observed.
+func userEnforceAccountEnabled(ctx context.Context, client *msgraph.UsersClient, id string, accountEnabled bool) error {
+	return helpers.WaitForUpdate(ctx, 3, func(ctx context.Context) (*bool, error) {
+		user, _, err := client.Get(ctx, id, odata.Query{Select: []string{"accountEnabled"}})
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, errors.New("user was nil")
+		}
+		if user.AccountEnabled != nil && *user.AccountEnabled == accountEnabled {
+			return utils.Bool(true), nil
+		}
+
+		if _, err := client.Update(ctx, msgraph.User{
+			DirectoryObject: msgraph.DirectoryObject{
+				ID: &id,
+			},
+			AccountEnabled: utils.Bool(accountEnabled),
+		}); err != nil {
+			return nil, err
+		}
+
+		return utils.Bool(false), nil
+	})
+}
+
+// userMailIgnoredWarning returns a warning when the mail address of a user does not reflect the configured value. This
+// can happen when the mail address is managed by Exchange Online, which may silently disregard the requested value.
+func userMailIgnoredWarning(configuredMail, mail string) diag.Diagnostics {
+	if configuredMail == "" || strings.EqualFold(configuredMail, mail) {
+		return nil
+	}
+
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The mail address %q was not applied", configuredMail),
+		Detail: fmt.Sprintf("The API accepted the mail address %q, but the user reports the mail address %q. This usually means that the user has an Exchange Online mailbox, "+
+			"in which case the mail address is managed by Exchange Online and cannot be set using this resource.", configuredMail, mail),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "mail"}},
+	}}
+}
+
+// userExtensionAttributeNames returns the names of the on-premises extension attributes that can be set for a user.
+func userExtensionAttributeNames() []string {
+	names := make([]string, 0, usersClient.UserExtensionAttributesCount)
+	for i := 1; i <= usersClient.UserExtensionAttributesCount; i++ {
+		names = append(names, fmt.Sprintf("extensionAttribute%d", i))
+	}
+	return names
+}
+
+func userValidateExtensionAttributes(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		return append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a map of extension attributes",
+			AttributePath: path,
+		})
+	}
+
+	valid := make(map[string]bool)
+	for _, name := range userExtensionAttributeNames() {
+		valid[name] = true
+	}
+
+	for k := range v {
+		if !valid[k] {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid extension attribute %q, must be one of extensionAttribute1 through extensionAttribute%d", k, usersClient.UserExtensionAttributesCount),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return
+}
+
+// expandUserExtensionAttributes returns all the on-premises extension attributes for a user, with any that are not
+// specified being set to nil so that they are cleared.
+func expandUserExtensionAttributes(in map[string]interface{}) usersClient.UserExtensionAttributes {
+	attributes := make(map[string]*string)
+	for _, name := range userExtensionAttributeNames() {
+		attributes[name] = nil
+		if v, ok := in[name]; ok && v.(string) != "" {
+			attributes[name] = utils.String(v.(string))
+		}
+	}
+
+	return usersClient.UserExtensionAttributes{
+		OnPremisesExtensionAttributes: attributes,
+	}
+}
+
+func flattenUserExtensionAttributes(in *usersClient.UserExtensionAttributes) map[string]interface{} {
+	result := make(map[string]interface{})
+	if in == nil {
+		return result
+	}
+
+	for k, v := range in.OnPremisesExtensionAttributes {
+		if v != nil && *v != "" {
+			result[k] = *v
+		}
+	}
+
+	return result
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/manicminer/hamilton/odata"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
@@ -83,6 +84,27 @@ func usersData() *schema.Resource {
 				ExactlyOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "return_all"},
 			},
 
+			"count_only": {
+				Description:  "Only retrieve the number of users, without returning the users themselves. Requires `return_all`",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"return_all"},
+			},
+
+			"eventual_consistency": {
+				Description: "Use an advanced query with eventual consistency when listing users, which is required by some tenants for filtering and may return results which do not yet reflect recent changes",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"user_count": {
+				Description: "The number of users found",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"users": {
 				Description: "A list of users",
 				Type:        schema.TypeList,
@@ -95,12 +117,54 @@ func usersData() *schema.Resource {
 							Computed:    true,
 						},
 
+						"city": {
+							Description: "The city in which the user is located",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"company_name": {
+							Description: "The company name which the user is associated. This property can be useful for describing the company that an external user comes from",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"country": {
+							Description: "The country/region in which the user is located, e.g. `US` or `UK`",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"department": {
+							Description: "The name for the department in which the user works",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"display_name": {
 							Description: "The display name of the user",
 							Type:        schema.TypeString,
 							Computed:    true,
 						},
 
+						"employee_id": {
+							Description: "The employee identifier assigned to the user by the organisation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"given_name": {
+							Description: "The given name (first name) of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"job_title": {
+							Description: "The user’s job title",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"mail": {
 							Description: "The primary email address of the user",
 							Type:        schema.TypeString,
@@ -113,12 +177,36 @@ func usersData() *schema.Resource {
 							Computed:    true,
 						},
 
+						"mobile_phone": {
+							Description: "The primary cellular telephone number for the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"object_id": {
 							Description: "The object ID of the user",
 							Type:        schema.TypeString,
 							Computed:    true,
 						},
 
+						"office_location": {
+							Description: "The office location in the user's place of business",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"onpremises_distinguished_name": {
+							Description: "The on-premise Active Directory distinguished name (DN) of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"onpremises_domain_name": {
+							Description: "The on-premise FQDN (i.e. dnsDomainName) of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"onpremises_immutable_id": {
 							Description: "The value used to associate an on-premises Active Directory user account with their Azure AD user object",
 							Type:        schema.TypeString,
@@ -131,12 +219,42 @@ func usersData() *schema.Resource {
 							Computed:    true,
 						},
 
+						"onpremises_security_identifier": {
+							Description: "The on-premise security identifier (SID) of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"onpremises_user_principal_name": {
 							Description: "The on-premise user principal name of the user",
 							Type:        schema.TypeString,
 							Computed:    true,
 						},
 
+						"postal_code": {
+							Description: "The postal code for the user's postal address. The postal code is specific to the user's country/region. In the United States of America, this attribute contains the ZIP code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"state": {
+							Description: "The state or province in the user's address",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"street_address": {
+							Description: "The street address of the user's place of business",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"surname": {
+							Description: "The user's surname (family name or last name)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"usage_location": {
 							Description: "The usage location of the user",
 							Type:        schema.TypeString,
@@ -155,6 +273,37 @@ func usersData() *schema.Resource {
 	}
 }
 
+// usersDataSourceSelect is the list of user properties requested from the API, which should be kept in sync with the
+// properties read in usersDataSourceRead
+var usersDataSourceSelect = []string{
+	"accountEnabled",
+	"city",
+	"companyName",
+	"country",
+	"department",
+	"displayName",
+	"employeeId",
+	"givenName",
+	"id",
+	"jobTitle",
+	"mail",
+	"mailNickname",
+	"mobilePhone",
+	"officeLocation",
+	"onPremisesDistinguishedName",
+	"onPremisesDomainName",
+	"onPremisesImmutableId",
+	"onPremisesSamAccountName",
+	"onPremisesSecurityIdentifier",
+	"onPremisesUserPrincipalName",
+	"postalCode",
+	"state",
+	"streetAddress",
+	"surname",
+	"usageLocation",
+	"userPrincipalName",
+}
+
 func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.UsersClient
 	client.BaseClient.DisableRetries = true
@@ -164,8 +313,31 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	ignoreMissing := d.Get("ignore_missing").(bool)
 	returnAll := d.Get("return_all").(bool)
 
+	newQuery := func(query odata.Query) odata.Query {
+		if d.Get("eventual_consistency").(bool) {
+			return helpers.AdvancedQuery(query)
+		}
+		return query
+	}
+
+	if returnAll && d.Get("count_only").(bool) {
+		count, err := helpers.CountDirectoryObjects(ctx, client.BaseClient, "/users", "")
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "count_only", "Could not retrieve the number of users")
+		}
+
+		d.SetId(fmt.Sprintf("users#%s#count", client.BaseClient.TenantId))
+		tf.Set(d, "user_count", count)
+		tf.Set(d, "mail_nicknames", []string{})
+		tf.Set(d, "object_ids", []string{})
+		tf.Set(d, "user_principal_names", []string{})
+		tf.Set(d, "users", []interface{}{})
+
+		return nil
+	}
+
 	if returnAll {
-		result, _, err := client.List(ctx, odata.Query{})
+		result, _, err := client.List(ctx, newQuery(odata.Query{Select: usersDataSourceSelect}))
 		if err != nil {
 			return tf.ErrorDiagF(err, "Could not retrieve users")
 		}
@@ -179,9 +351,10 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	} else if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
 		expectedCount = len(upns)
 		for _, v := range upns {
-			query := odata.Query{
+			query := newQuery(odata.Query{
 				Filter: fmt.Sprintf("userPrincipalName eq '%s'", utils.EscapeSingleQuote(v.(string))),
-			}
+				Select: usersDataSourceSelect,
+			})
 			result, _, err := client.List(ctx, query)
 			if err != nil {
 				return tf.ErrorDiagF(err, "Finding user with UPN: %q", v)
@@ -204,7 +377,7 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		if objectIds, ok := d.Get("object_ids").([]interface{}); ok && len(objectIds) > 0 {
 			expectedCount = len(objectIds)
 			for _, v := range objectIds {
-				u, status, err := client.Get(ctx, v.(string), odata.Query{})
+				u, status, err := client.Get(ctx, v.(string), odata.Query{Select: usersDataSourceSelect})
 				if err != nil {
 					if status == http.StatusNotFound {
 						if ignoreMissing {
@@ -222,9 +395,10 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		} else if mailNicknames, ok := d.Get("mail_nicknames").([]interface{}); ok && len(mailNicknames) > 0 {
 			expectedCount = len(mailNicknames)
 			for _, v := range mailNicknames {
-				query := odata.Query{
+				query := newQuery(odata.Query{
 					Filter: fmt.Sprintf("mailNickname eq '%s'", utils.EscapeSingleQuote(v.(string))),
-				}
+					Select: usersDataSourceSelect,
+				})
 				result, _, err := client.List(ctx, query)
 				if err != nil {
 					return tf.ErrorDiagF(err, "Finding user with email alias: %q", v)
@@ -269,13 +443,29 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 		user := make(map[string]interface{})
 		user["account_enabled"] = u.AccountEnabled
+		user["city"] = u.City
+		user["company_name"] = u.CompanyName
+		user["country"] = u.Country
+		user["department"] = u.Department
 		user["display_name"] = u.DisplayName
+		user["employee_id"] = u.EmployeeId
+		user["given_name"] = u.GivenName
+		user["job_title"] = u.JobTitle
 		user["mail"] = u.Mail
 		user["mail_nickname"] = u.MailNickname
+		user["mobile_phone"] = u.MobilePhone
 		user["object_id"] = u.ID
+		user["office_location"] = u.OfficeLocation
+		user["onpremises_distinguished_name"] = u.OnPremisesDistinguishedName
+		user["onpremises_domain_name"] = u.OnPremisesDomainName
 		user["onpremises_immutable_id"] = u.OnPremisesImmutableId
 		user["onpremises_sam_account_name"] = u.OnPremisesSamAccountName
+		user["onpremises_security_identifier"] = u.OnPremisesSecurityIdentifier
 		user["onpremises_user_principal_name"] = u.OnPremisesUserPrincipalName
+		user["postal_code"] = u.PostalCode
+		user["state"] = u.State
+		user["street_address"] = u.StreetAddress
+		user["surname"] = u.Surname
 		user["usage_location"] = u.UsageLocation
 		user["user_principal_name"] = u.UserPrincipalName
 		userList = append(userList, user)
@@ -288,6 +478,7 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.SetId("users#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "user_count", len(userList))
 	tf.Set(d, "mail_nicknames", mailNicknames)
 	tf.Set(d, "object_ids", objectIds)
 	tf.Set(d, "user_principal_names", upns)
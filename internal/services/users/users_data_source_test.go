@@ -51,6 +51,27 @@ func TestAccUsersDataSource_byObjectIds(t *testing.T) {
 	}})
 }
 
+func TestAccUsersDataSource_profile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: UsersDataSource{}.profile(data),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("users.#").HasValue("2"),
+			check.That(data.ResourceName).Key("users.0.company_name").HasValue(fmt.Sprintf("acctestUser-%d-Company", data.RandomInteger)),
+			check.That(data.ResourceName).Key("users.0.department").HasValue(fmt.Sprintf("acctestUser-%d-DeptA", data.RandomInteger)),
+			check.That(data.ResourceName).Key("users.0.job_title").HasValue("Engineer"),
+			check.That(data.ResourceName).Key("users.1.company_name").HasValue(fmt.Sprintf("acctestUser-%d-Company", data.RandomInteger)),
+			check.That(data.ResourceName).Key("users.1.department").HasValue(fmt.Sprintf("acctestUser-%d-DeptB", data.RandomInteger)),
+			check.That(data.ResourceName).Key("users.1.city").HasValue("London"),
+			// Cloud-only users have no on-premises attributes, but these should still be surfaced
+			check.That(data.ResourceName).Key("users.0.onpremises_distinguished_name").IsEmpty(),
+			check.That(data.ResourceName).Key("users.0.onpremises_domain_name").IsEmpty(),
+			check.That(data.ResourceName).Key("users.0.onpremises_security_identifier").IsEmpty(),
+		),
+	}})
+}
+
 func TestAccUsersDataSource_byObjectIdsIgnoreMissing(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
 
@@ -106,6 +127,20 @@ func TestAccUsersDataSource_noNames(t *testing.T) {
 	}})
 }
 
+func TestAccUsersDataSource_returnAllEventualConsistency(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: UsersDataSource{}.returnAllEventualConsistency(),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_principal_names.#").Exists(),
+			check.That(data.ResourceName).Key("object_ids.#").Exists(),
+			check.That(data.ResourceName).Key("mail_nicknames.#").Exists(),
+			check.That(data.ResourceName).Key("users.#").Exists(),
+		),
+	}})
+}
+
 func TestAccUsersDataSource_returnAll(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
 
@@ -120,6 +155,18 @@ func TestAccUsersDataSource_returnAll(t *testing.T) {
 	}})
 }
 
+func TestAccUsersDataSource_countOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{{
+		Config: UsersDataSource{}.countOnly(),
+		Check: resource.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_count").Exists(),
+			check.That(data.ResourceName).Key("users.#").HasValue("0"),
+		),
+	}})
+}
+
 func (UsersDataSource) byUserPrincipalNames(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -157,6 +204,36 @@ data "azuread_users" "test" {
 `, UserResource{}.threeUsersABC(data))
 }
 
+func (UsersDataSource) profile(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "testA" {
+  user_principal_name = "acctestUser.%[1]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-A"
+  password            = "%[2]s"
+  company_name        = "acctestUser-%[1]d-Company"
+  department          = "acctestUser-%[1]d-DeptA"
+  job_title           = "Engineer"
+}
+
+resource "azuread_user" "testB" {
+  user_principal_name = "acctestUser.%[1]d.B@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-B"
+  password            = "%[2]s"
+  city                = "London"
+  company_name        = "acctestUser-%[1]d-Company"
+  department          = "acctestUser-%[1]d-DeptB"
+}
+
+data "azuread_users" "test" {
+  object_ids = [azuread_user.testA.object_id, azuread_user.testB.object_id]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
 func (UsersDataSource) byObjectIdsIgnoreMissing(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -214,3 +291,21 @@ data "azuread_users" "test" {
 }
 `
 }
+
+func (UsersDataSource) returnAllEventualConsistency() string {
+	return `
+data "azuread_users" "test" {
+  return_all           = true
+  eventual_consistency = true
+}
+`
+}
+
+func (UsersDataSource) countOnly() string {
+	return `
+data "azuread_users" "test" {
+  return_all = true
+  count_only = true
+}
+`
+}
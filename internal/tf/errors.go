@@ -1,10 +1,13 @@
 package tf
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/manicminer/hamilton/odata"
 )
 
 func ErrorDiagF(err error, format string, a ...interface{}) diag.Diagnostics {
@@ -25,6 +28,58 @@ func ErrorDiagPathF(err error, attr string, summary string, a ...interface{}) di
 	return diag.Diagnostics{d}
 }
 
+// ODataErrorDiagF returns a diagnostic for an error returned by the Microsoft Graph API, including the OData error code
+// and message in the summary when these can be determined from the error.
+func ODataErrorDiagF(err error, format string, a ...interface{}) diag.Diagnostics {
+	return ODataErrorDiagPathF(err, "", format, a...)
+}
+
+// ODataErrorDiagPathF returns a diagnostic for an error returned by the Microsoft Graph API for the specified attribute,
+// including the OData error code and message in the summary when these can be determined from the error.
+func ODataErrorDiagPathF(err error, attr string, summary string, a ...interface{}) diag.Diagnostics {
+	d := ErrorDiagPathF(err, attr, summary, a...)
+	if code, message, ok := ODataError(err); ok {
+		d[0].Summary = fmt.Sprintf("%s: %s: %s", d[0].Summary, code, message)
+	}
+	return d
+}
+
+// ODataError attempts to extract the OData error code and message from an error returned by the Hamilton SDK, which
+// includes these in the error text either in their parsed form, or as the raw response body when this could not be
+// parsed. Returns false when no error code could be found.
+func ODataError(err error) (code string, message string, ok bool) {
+	if err == nil {
+		return
+	}
+
+	errText := err.Error()
+
+	if i := strings.Index(errText, "OData error: "); i >= 0 {
+		parts := strings.SplitN(errText[i+len("OData error: "):], ": ", 2)
+		code = strings.TrimSpace(parts[0])
+		if len(parts) > 1 {
+			message = strings.TrimSpace(parts[1])
+		}
+		return code, message, code != ""
+	}
+
+	if i := strings.Index(errText, "response: "); i >= 0 {
+		var body struct {
+			Error *odata.Error `json:"error"`
+		}
+		if json.Unmarshal([]byte(errText[i+len("response: "):]), &body) != nil || body.Error == nil || body.Error.Code == nil {
+			return
+		}
+		code = *body.Error.Code
+		if body.Error.Message != nil {
+			message = *body.Error.Message
+		}
+		return code, message, code != ""
+	}
+
+	return
+}
+
 func ImportAsDuplicateError(resourceName, id, name string) error {
 	d := ImportAsDuplicateDiag(resourceName, id, name)
 	if len(d) > 0 {
@@ -0,0 +1,73 @@
+package tf
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestODataError(t *testing.T) {
+	cases := []struct {
+		Err             error
+		ExpectedCode    string
+		ExpectedMessage string
+		ExpectedOk      bool
+	}{
+		{
+			Err: nil,
+		},
+		{
+			Err: errors.New("context deadline exceeded"),
+		},
+		{
+			Err:             errors.New("ApplicationsClient.BaseClient.Post(): unexpected status 403 with OData error: Authorization_RequestDenied: Insufficient privileges to complete the operation."),
+			ExpectedCode:    "Authorization_RequestDenied",
+			ExpectedMessage: "Insufficient privileges to complete the operation.",
+			ExpectedOk:      true,
+		},
+		{
+			Err:             errors.New("GroupsClient.BaseClient.Delete(): unexpected status 404 with OData error: Request_ResourceNotFound"),
+			ExpectedCode:    "Request_ResourceNotFound",
+			ExpectedMessage: "",
+			ExpectedOk:      true,
+		},
+		{
+			Err:             errors.New(`UsersClient.BaseClient.Patch(): unexpected status 400 with response: {"error":{"code":"Request_BadRequest","message":"Invalid value specified for property 'mobilePhone' of resource 'User'."}}`),
+			ExpectedCode:    "Request_BadRequest",
+			ExpectedMessage: "Invalid value specified for property 'mobilePhone' of resource 'User'.",
+			ExpectedOk:      true,
+		},
+		{
+			Err: errors.New("UsersClient.BaseClient.Patch(): unexpected status 502 with response: <html>Bad Gateway</html>"),
+		},
+		{
+			Err: errors.New("UsersClient.BaseClient.Patch(): unexpected status 500 received with no body"),
+		},
+	}
+
+	for _, tc := range cases {
+		code, message, ok := ODataError(tc.Err)
+		if code != tc.ExpectedCode || message != tc.ExpectedMessage || ok != tc.ExpectedOk {
+			t.Fatalf("Expected (%q, %q, %t) for error %v, got (%q, %q, %t)", tc.ExpectedCode, tc.ExpectedMessage, tc.ExpectedOk, tc.Err, code, message, ok)
+		}
+	}
+}
+
+func TestODataErrorDiagF(t *testing.T) {
+	err := errors.New("ApplicationsClient.BaseClient.Post(): unexpected status 403 with OData error: Authorization_RequestDenied: Insufficient privileges to complete the operation.")
+
+	d := ODataErrorDiagF(err, "Could not create application")
+	if len(d) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d", len(d))
+	}
+	if expected := "Could not create application: Authorization_RequestDenied: Insufficient privileges to complete the operation."; d[0].Summary != expected {
+		t.Fatalf("Expected summary %q, got %q", expected, d[0].Summary)
+	}
+	if d[0].Detail != err.Error() {
+		t.Fatalf("Expected detail %q, got %q", err.Error(), d[0].Detail)
+	}
+
+	d = ODataErrorDiagF(errors.New("context deadline exceeded"), "Could not create application")
+	if expected := "Could not create application"; d[0].Summary != expected {
+		t.Fatalf("Expected summary %q, got %q", expected, d[0].Summary)
+	}
+}
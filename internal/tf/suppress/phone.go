@@ -0,0 +1,35 @@
+package suppress
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PhoneNumber suppresses differences between two phone numbers which differ only in formatting, e.g. when the API
+// normalizes `+1 (425) 555-0100` to `+14255550100`
+func PhoneNumber(_, old, new string, _ *schema.ResourceData) bool {
+	oldNumber, newNumber := normalizePhoneNumber(old), normalizePhoneNumber(new)
+	if strings.TrimPrefix(oldNumber, "+") == "" || strings.TrimPrefix(newNumber, "+") == "" {
+		return old == new
+	}
+	return oldNumber == newNumber
+}
+
+// normalizePhoneNumber strips all characters from a phone number except digits and a leading plus sign
+func normalizePhoneNumber(in string) string {
+	in = strings.TrimSpace(in)
+
+	var b strings.Builder
+	if strings.HasPrefix(in, "+") {
+		b.WriteRune('+')
+	}
+	for _, r := range in {
+		if unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
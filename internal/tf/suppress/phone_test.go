@@ -0,0 +1,63 @@
+package suppress
+
+import "testing"
+
+func TestPhoneNumber(t *testing.T) {
+	cases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			old:      "+14255550100",
+			new:      "+1 425 555 0100",
+			suppress: true,
+		},
+		{
+			old:      "+1 (425) 555-0100",
+			new:      "+14255550100",
+			suppress: true,
+		},
+		{
+			old:      "+44.20.7946.0000",
+			new:      "+44 20 7946 0000",
+			suppress: true,
+		},
+		{
+			old:      "(555) 555-5555",
+			new:      "555 555 5555",
+			suppress: true,
+		},
+		{
+			old:      "+14255550100",
+			new:      "14255550100",
+			suppress: false,
+		},
+		{
+			old:      "+14255550100",
+			new:      "+14255550101",
+			suppress: false,
+		},
+		{
+			old:      "",
+			new:      "+14255550100",
+			suppress: false,
+		},
+		{
+			old:      "+14255550100",
+			new:      "",
+			suppress: false,
+		},
+		{
+			old:      "",
+			new:      "",
+			suppress: true,
+		},
+	}
+
+	for _, tc := range cases {
+		if PhoneNumber("mobile_phone", tc.old, tc.new, nil) != tc.suppress {
+			t.Fatalf("expected suppress to be %t for %q and %q", tc.suppress, tc.old, tc.new)
+		}
+	}
+}
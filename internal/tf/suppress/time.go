@@ -0,0 +1,21 @@
+package suppress
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RFC3339Time suppresses differences between two RFC3339 timestamps which represent the same instant, e.g. when the
+// API returns a timestamp in UTC which was specified with a different offset
+func RFC3339Time(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
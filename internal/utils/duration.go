@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RelativeDurationFormat describes the format accepted by AddRelativeDuration, for use in error messages
+const RelativeDurationFormat = "a sequence of whole numbers with a unit suffix, where the unit is one of `y` (years), `mo` (months), `w` (weeks), `d` (days), `h` (hours), `m` (minutes) or `s` (seconds), e.g. `1y`, `90d`, `1y6mo` or `8760h`"
+
+var relativeDurationPart = regexp.MustCompile(`(\d+)(y|mo|w|d|h|m|s)`)
+
+// AddRelativeDuration adds a human-readable relative duration, such as `1y6mo` or `240h`, to the provided time. Any
+// duration understood by time.ParseDuration is also accepted. Years, months, weeks and days are added using calendar
+// arithmetic, so that `1y` always results in the same day of the following year.
+func AddRelativeDuration(t time.Time, v string) (*time.Time, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil, fmt.Errorf("duration must not be empty")
+	}
+
+	// Any value supported by time.ParseDuration continues to be accepted as-is
+	if d, err := time.ParseDuration(v); err == nil {
+		result := t.Add(d)
+		return &result, nil
+	}
+
+	// Ensure that the entire value consists of valid parts, so that typos are not silently ignored
+	if relativeDurationPart.ReplaceAllString(v, "") != "" {
+		return nil, fmt.Errorf("invalid duration %q, expected %s", v, RelativeDurationFormat)
+	}
+
+	var years, months, days int
+	var duration time.Duration
+	for _, part := range relativeDurationPart.FindAllStringSubmatch(v, -1) {
+		n, err := strconv.Atoi(part[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q: %+v", v, err)
+		}
+
+		switch part[2] {
+		case "y":
+			years += n
+		case "mo":
+			months += n
+		case "w":
+			days += n * 7
+		case "d":
+			days += n
+		case "h":
+			duration += time.Duration(n) * time.Hour
+		case "m":
+			duration += time.Duration(n) * time.Minute
+		case "s":
+			duration += time.Duration(n) * time.Second
+		}
+	}
+
+	result := t.AddDate(years, months, days).Add(duration)
+	return &result, nil
+}
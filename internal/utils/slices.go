@@ -17,6 +17,21 @@ func Difference(a, b []string) []string {
 	return diff
 }
 
+// Intersection returns the elements in `a` that are also in `b`.
+func Intersection(a, b []string) []string {
+	mb := make(map[string]struct{}, len(b))
+	for _, x := range b {
+		mb[x] = struct{}{}
+	}
+	var result []string
+	for _, x := range a {
+		if _, found := mb[x]; found {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
 // EnsureStringInSlice ensures the given string is contained in a slice
 func EnsureStringInSlice(sl []string, in string) []string {
 	for _, s := range sl {
@@ -26,3 +41,8 @@ func EnsureStringInSlice(sl []string, in string) []string {
 	}
 	return append(sl, in)
 }
+
+// EqualStringSets returns true when `a` and `b` contain the same elements, disregarding order and duplicates.
+func EqualStringSets(a, b []string) bool {
+	return len(Difference(a, b)) == 0 && len(Difference(b, a)) == 0
+}
@@ -0,0 +1,32 @@
+package validate
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// IsCIDR validates that the value is an IPv4 or IPv6 address range in CIDR notation
+func IsCIDR(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(v); err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Value %q must be a valid IPv4 or IPv6 range in CIDR notation, e.g. 10.0.0.0/16 or 2001:db8::/32", v),
+			AttributePath: path,
+		})
+	}
+
+	return
+}
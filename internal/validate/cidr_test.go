@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestIsCIDR(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "10.0.0.1",
+			Errors: 1,
+		},
+		{
+			Input:  "10.0.0.0/33",
+			Errors: 1,
+		},
+		{
+			Input:  "10.0.0.0/16",
+			Errors: 0,
+		},
+		{
+			Input:  "2001:db8::/129",
+			Errors: 1,
+		},
+		{
+			Input:  "2001:db8::/32",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			diags := IsCIDR(tc.Input, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected IsCIDR to have %d not %d errors for %q", tc.Errors, len(diags), tc.Input)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+// RelativeDuration validates that the string is a relative duration that can be parsed by utils.AddRelativeDuration,
+// for example `240h`, `90d` or `1y6mo`
+func RelativeDuration(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	now := time.Now()
+	t, err := utils.AddRelativeDuration(now, v)
+	if err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Value %q is not a valid relative duration", v),
+			Detail:        fmt.Sprintf("Expected %s", utils.RelativeDurationFormat),
+			AttributePath: path,
+		})
+		return
+	}
+
+	if !t.After(now) {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Value %q must be a duration greater than zero", v),
+			AttributePath: path,
+		})
+	}
+
+	return
+}
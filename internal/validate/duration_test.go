@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestRelativeDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "8760h",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2400h30m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1.5h",
+			ErrCount: 0,
+		},
+		{
+			Value:    "90d",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2w",
+			ErrCount: 0,
+		},
+		{
+			Value:    "6mo",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1y",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1y6mo15d",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1 year",
+			ErrCount: 1,
+		},
+		{
+			Value:    "P1Y",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1x",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-24h",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0d",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			diags := RelativeDuration(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected RelativeDuration to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.Value)
+			}
+		})
+	}
+}
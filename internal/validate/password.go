@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// passwordMinLength and passwordMaxLength are the length limits imposed by the default Azure AD password policy
+const (
+	passwordMinLength = 8
+	passwordMaxLength = 256
+)
+
+// Password validates that the given string satisfies the complexity requirements of the default Azure AD password
+// policy, i.e. it is between 8 and 256 characters long, contains only printable ASCII characters, and contains at
+// least three of the following: lowercase letters, uppercase letters, numbers and symbols
+func Password(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if len(v) < passwordMinLength || len(v) > passwordMaxLength {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Password must be between 8 and 256 characters long",
+			AttributePath: path,
+		})
+	}
+
+	var lower, upper, number, symbol bool
+	for _, c := range v {
+		switch {
+		case c > unicode.MaxASCII || !unicode.IsPrint(c):
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Password must only contain printable ASCII characters",
+				AttributePath: path,
+			})
+			return
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsDigit(c):
+			number = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, present := range []bool{lower, upper, number, symbol} {
+		if present {
+			classes++
+		}
+	}
+	if classes < 3 {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Password must contain at least three of the following: lowercase letters, uppercase letters, numbers and symbols",
+			AttributePath: path,
+		})
+	}
+
+	return
+}
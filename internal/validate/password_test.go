@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestPassword(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "Sup3rS3cret",
+			TestName: "Valid",
+			ErrCount: 0,
+		},
+		{
+			Value:    "correct horse battery staple 1",
+			TestName: "ValidPassphrase",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Ab1!",
+			TestName: "TooShort",
+			ErrCount: 1,
+		},
+		{
+			Value:    "alllowercase",
+			TestName: "OneCharacterClass",
+			ErrCount: 1,
+		},
+		{
+			Value:    "lowerUPPER",
+			TestName: "TwoCharacterClasses",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			TestName: "TooShortAndOneCharacterClass",
+			ErrCount: 2,
+		},
+		{
+			Value:    "Pässw0rd1234",
+			TestName: "NonASCII",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := Password(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected Password to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}
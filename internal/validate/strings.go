@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // NoEmptyStrings validates that the string is not just whitespace characters (equal to [\r\n\t\f\v ])
@@ -31,6 +32,17 @@ func NoEmptyStrings(i interface{}, path cty.Path) (ret diag.Diagnostics) {
 	return
 }
 
+// EmptyStringOr validates that the string is either empty, or satisfies the provided validation function. This allows
+// an optional property to be cleared by explicitly setting it to an empty string.
+func EmptyStringOr(f schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		if v, ok := i.(string); ok && v == "" {
+			return nil
+		}
+		return f(i, path)
+	}
+}
+
 // StringIsEmailAddress validates that the given string is a valid email address (foo@bar.com)
 func StringIsEmailAddress(i interface{}, path cty.Path) (ret diag.Diagnostics) {
 	v, ok := i.(string)
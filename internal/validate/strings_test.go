@@ -100,6 +100,45 @@ func TestNoEmptyStrings(t *testing.T) {
 	}
 }
 
+func TestEmptyStringOr(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			TestName: "Empty",
+			ErrCount: 0,
+		},
+		{
+			Value:    " ",
+			TestName: "Space",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://www.example.com",
+			TestName: "ValidUrl",
+			ErrCount: 0,
+		},
+		{
+			Value:    "www.example.com",
+			TestName: "InvalidUrl",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := EmptyStringOr(IsHttpOrHttpsUrl)(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected EmptyStringOr to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}
+
 func TestStringIsEmailAddress(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -28,12 +28,24 @@ func IsLogoutUrl(i interface{}, path cty.Path) (ret diag.Diagnostics) {
 		return
 	}
 
-	if len(i.(string)) > 255 {
+	v := i.(string)
+
+	if len(v) > 255 {
 		ret = append(ret, diag.Diagnostic{
 			Severity:      diag.Error,
 			Summary:       "URL must be 255 characters or less",
 			AttributePath: path,
 		})
+		return
+	}
+
+	// Logout URLs must use https, except for loopback addresses used during development
+	if u, err := url.Parse(v); err == nil && strings.EqualFold(u.Scheme, "http") && !isLoopbackHost(u.Hostname()) {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid logout URL %q: the http scheme is only supported for localhost URLs", v),
+			AttributePath: path,
+		})
 	}
 
 	return
@@ -49,13 +61,44 @@ func IsRedirectUriFunc(urnAllowed bool, publicClient bool) schema.SchemaValidate
 
 		ret = IsUriFunc(allowedSchemes, urnAllowed, true)(i, path)
 		if len(ret) > 0 {
+			if v, ok := i.(string); ok {
+				for n := range ret {
+					ret[n].Summary = fmt.Sprintf("Invalid redirect URI %q: %s", v, ret[n].Summary)
+				}
+			}
 			return
 		}
 
-		if len(i.(string)) > 256 {
+		v := i.(string)
+
+		if len(v) > 256 {
 			ret = append(ret, diag.Diagnostic{
 				Severity:      diag.Error,
-				Summary:       "URI must be 256 characters or less",
+				Summary:       fmt.Sprintf("Invalid redirect URI %q: URI must be 256 characters or less", v),
+				AttributePath: path,
+			})
+			return
+		}
+
+		// See https://docs.microsoft.com/en-us/azure/active-directory/develop/reply-url
+		u, err := url.Parse(v)
+		if err != nil || u.Scheme == "urn" {
+			return
+		}
+
+		if strings.Contains(v, "#") {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid redirect URI %q: URI must not contain a fragment", v),
+				AttributePath: path,
+			})
+			return
+		}
+
+		if strings.EqualFold(u.Scheme, "http") && !isLoopbackHost(u.Hostname()) {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid redirect URI %q: the http scheme is only supported for localhost URIs", v),
 				AttributePath: path,
 			})
 		}
@@ -64,6 +107,14 @@ func IsRedirectUriFunc(urnAllowed bool, publicClient bool) schema.SchemaValidate
 	}
 }
 
+func isLoopbackHost(host string) bool {
+	switch strings.ToLower(host) {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	}
+	return false
+}
+
 func IsUriFunc(validURLSchemes []string, urnAllowed bool, forceTrailingSlash bool) schema.SchemaValidateDiagFunc {
 	return func(i interface{}, path cty.Path) (ret diag.Diagnostics) {
 		v, ok := i.(string)
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -147,3 +148,127 @@ func TestIsAppURI(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRedirectURI(t *testing.T) {
+	cases := []struct {
+		Url          string
+		PublicClient bool
+		Errors       int
+	}{
+		{
+			Url:    "",
+			Errors: 1,
+		},
+		{
+			Url:    "this is not a url",
+			Errors: 1,
+		},
+		{
+			Url:    "https://www.example.com",
+			Errors: 1,
+		},
+		{
+			Url:    "https://www.example.com/",
+			Errors: 0,
+		},
+		{
+			Url:    "https://*.example.com/callback",
+			Errors: 0,
+		},
+		{
+			Url:    "https://www.example.com/callback#fragment",
+			Errors: 1,
+		},
+		{
+			Url:    "http://www.example.com/",
+			Errors: 1,
+		},
+		{
+			Url:    "http://localhost:8080/",
+			Errors: 0,
+		},
+		{
+			Url:    "http://127.0.0.1/callback",
+			Errors: 0,
+		},
+		{
+			Url:    "ms-appx-web://Microsoft.AAD.BrokerPlugin/00000000-0000-0000-0000-000000000000",
+			Errors: 0,
+		},
+		{
+			Url:    "myapp://auth/",
+			Errors: 1,
+		},
+		{
+			Url:          "myapp://auth/",
+			PublicClient: true,
+			Errors:       0,
+		},
+		{
+			Url:          "myapp://auth/#fragment",
+			PublicClient: true,
+			Errors:       1,
+		},
+		{
+			Url:          "urn:ietf:wg:oauth:2.0:oob",
+			PublicClient: true,
+			Errors:       0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Url, func(t *testing.T) {
+			diags := IsRedirectUriFunc(true, tc.PublicClient)(tc.Url, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected IsRedirectUriFunc to have %d not %d errors for %q", tc.Errors, len(diags), tc.Url)
+			}
+		})
+	}
+}
+
+func TestIsLogoutURL(t *testing.T) {
+	cases := []struct {
+		Url    string
+		Errors int
+	}{
+		{
+			Url:    "",
+			Errors: 1,
+		},
+		{
+			Url:    "ftp://www.example.com/logout",
+			Errors: 1,
+		},
+		{
+			Url:    "http://www.example.com/logout",
+			Errors: 1,
+		},
+		{
+			Url:    "https://www.example.com/logout",
+			Errors: 0,
+		},
+		{
+			Url:    "https://www.example.com/saml/logout?wa=wsignout1.0",
+			Errors: 0,
+		},
+		{
+			Url:    "http://localhost:8080/logout",
+			Errors: 0,
+		},
+		{
+			Url:    "https://www.example.com/" + strings.Repeat("a", 250),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Url, func(t *testing.T) {
+			diags := IsLogoutUrl(tc.Url, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected IsLogoutUrl to have %d not %d errors for %q", tc.Errors, len(diags), tc.Url)
+			}
+		})
+	}
+}